@@ -0,0 +1,300 @@
+package dusk
+
+import (
+	"math"
+	"time"
+)
+
+/*
+	the semi-major axis (in metres) and the flattening of the WGS84 reference ellipsoid
+*/
+var WGS84_SEMI_MAJOR_AXIS float64 = 6378137
+
+var WGS84_FLATTENING float64 = 1 / 298.257223563
+
+type GeographicCoordinate struct {
+	/*
+		ϕ - the latitude in degrees (south is negative, north is positive)
+	*/
+	Latitude float64 `json:"latitude"`
+	/*
+		λ - the longitude in degrees (west is negative, east is positive)
+	*/
+	Longitude float64 `json:"longitude"`
+	/*
+		h - the elevation (above sea level) in metres
+	*/
+	Elevation float64 `json:"elevation"`
+}
+
+type Alignment struct {
+	/*
+		datetime of the closest approach of the body to the target direction
+	*/
+	Datetime time.Time `json:"datetime"`
+	/*
+		apparent altitude (a) of the body, in degrees
+	*/
+	Altitude float64 `json:"altitude"`
+	/*
+		azimuth (A) of the body, eastwards from north, in degrees
+	*/
+	Azimuth float64 `json:"azimuth"`
+	/*
+		angular separation of the body from the target direction, in degrees
+	*/
+	Separation float64 `json:"separation"`
+}
+
+/*
+	convertGeographicCoordinateToCartesian()
+
+	@param g - the geographic coordinate of type GeographicCoordinate { ϕ, λ, h }
+	@returns the Earth-centred, Earth-fixed cartesian coordinate (x, y, z) of the point on the WGS84 ellipsoid (in metres)
+*/
+func convertGeographicCoordinateToCartesian(g GeographicCoordinate) [3]float64 {
+	// the square of the eccentricity of the ellipsoid:
+	var e2 = WGS84_FLATTENING * (2 - WGS84_FLATTENING)
+
+	// the radius of curvature in the prime vertical:
+	var N = WGS84_SEMI_MAJOR_AXIS / math.Sqrt(1-e2*math.Pow(sinx(g.Latitude), 2))
+
+	return [3]float64{
+		(N + g.Elevation) * cosx(g.Latitude) * cosx(g.Longitude),
+		(N + g.Elevation) * cosx(g.Latitude) * sinx(g.Longitude),
+		(N*(1-e2) + g.Elevation) * sinx(g.Latitude),
+	}
+}
+
+/*
+	GetGeodesicBearingAndElevation()
+
+	N.B. the elevation angle accounts for the curvature of the Earth, but not for terrestrial refraction.
+
+	@param observer - the geographic coordinate of the observer
+	@param target - the geographic coordinate of the target, e.g., the summit of a landmark
+	@returns the horizontal coordinate of the target as seen by the observer, with the azimuth measured eastwards from
+	north (in degrees), and the straight-line distance to the target (in metres)
+*/
+func GetGeodesicBearingAndElevation(observer GeographicCoordinate, target GeographicCoordinate) (HorizontalCoordinate, float64) {
+	var o = convertGeographicCoordinateToCartesian(observer)
+
+	var t = convertGeographicCoordinateToCartesian(target)
+
+	var dx, dy, dz = t[0] - o[0], t[1] - o[1], t[2] - o[2]
+
+	var ϕ, λ = observer.Latitude, observer.Longitude
+
+	// rotate into the local east, north and up frame of the observer:
+	var east = -sinx(λ)*dx + cosx(λ)*dy
+
+	var north = -sinx(ϕ)*cosx(λ)*dx - sinx(ϕ)*sinx(λ)*dy + cosx(ϕ)*dz
+
+	var up = cosx(ϕ)*cosx(λ)*dx + cosx(ϕ)*sinx(λ)*dy + sinx(ϕ)*dz
+
+	var A = atan2yx(east, north)
+
+	// correct for negative angles
+	if A < 0 {
+		A += 360
+	}
+
+	return HorizontalCoordinate{
+		Altitude: atan2yx(up, math.Hypot(east, north)),
+		Azimuth:  A,
+	}, math.Sqrt(dx*dx + dy*dy + dz*dz)
+}
+
+/*
+	getBodyApparentHorizontalPosition()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param body - the body of type Body, e.g., BodyMoon
+	@param observer - the geographic coordinate of the observer
+	@param ephemeris - the ephemeris backend, e.g., VSOP87Ephemeris{}
+	@returns the horizontal coordinate of the body, corrected for the parallax in altitude and for atmospheric refraction
+	down to a geometric altitude of -1°
+*/
+func getBodyApparentHorizontalPosition(datetime time.Time, body Body, observer GeographicCoordinate, ephemeris Ephemeris) (HorizontalCoordinate, error) {
+	ec, err := ephemeris.GetEclipticPosition(datetime, body)
+
+	if err != nil {
+		return HorizontalCoordinate{}, err
+	}
+
+	var eq = ConvertEclipticCoordinateToEquatorial(datetime, ec)
+
+	var H = getApparentHourAngle(datetime, observer.Longitude, eq.RightAscension)
+
+	var hz = getHorizontalCoordinateFromHourAngle(H, eq.Declination, observer.Latitude)
+
+	// the parallax in altitude, where the distance of the body is known (e.g., up to ~1° for the Moon):
+	if ec.Δ > 0 {
+		hz.Altitude -= asinx(WGS84_SEMI_MAJOR_AXIS / 1000 / ec.Δ * cosx(hz.Altitude))
+	}
+
+	if R := GetAtmosphericRefraction(hz.Altitude); R != nil {
+		hz.Altitude += *R
+	}
+
+	return hz, nil
+}
+
+/*
+	getAlignmentSeparation()
+
+	@param hz - the horizontal coordinate of the body
+	@param target - the horizontal coordinate of the target direction, where the altitude is NaN for any altitude
+	@returns the angular separation of the body from the target direction (in degrees)
+*/
+func getAlignmentSeparation(hz HorizontalCoordinate, target HorizontalCoordinate) float64 {
+	if math.IsNaN(target.Altitude) {
+		return math.Abs(math.Mod(hz.Azimuth-target.Azimuth+540, 360) - 180)
+	}
+
+	return GetAngularSeparation(Coordinate{Latitude: hz.Altitude, Longitude: hz.Azimuth}, Coordinate{Latitude: target.Altitude, Longitude: target.Azimuth})
+}
+
+/*
+	getAlignment()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param body - the body of type Body, e.g., BodyMoon
+	@param observer - the geographic coordinate of the observer
+	@param target - the horizontal coordinate of the target direction, where the altitude is NaN for any altitude
+	@param ephemeris - the ephemeris backend, e.g., VSOP87Ephemeris{}
+	@returns the alignment of the body with the target direction at the datetime
+*/
+func getAlignment(datetime time.Time, body Body, observer GeographicCoordinate, target HorizontalCoordinate, ephemeris Ephemeris) (Alignment, error) {
+	hz, err := getBodyApparentHorizontalPosition(datetime, body, observer, ephemeris)
+
+	if err != nil {
+		return Alignment{}, err
+	}
+
+	return Alignment{
+		Datetime:   datetime,
+		Altitude:   hz.Altitude,
+		Azimuth:    hz.Azimuth,
+		Separation: getAlignmentSeparation(hz, target),
+	}, nil
+}
+
+/*
+	GetBodyAlignments()
+
+	N.B. the body is sampled every 5 minutes, and each closest approach is refined to the second; where only the azimuth
+	is given, alignments with the body below the apparent horizon are omitted, after refraction has lifted it into view.
+
+	@param from - the datetime of the start of the search (in UTC)
+	@param until - the datetime of the end of the search (in UTC)
+	@param body - the body of type Body, e.g., BodySun
+	@param observer - the geographic coordinate of the observer
+	@param target - the horizontal coordinate of the target direction, where the altitude is NaN to match the azimuth only
+	@param tolerance - the maximum angular separation of the body from the target direction (in degrees)
+	@param ephemeris - the ephemeris backend, e.g., VSOP87Ephemeris{}
+	@returns the closest approaches of the body to the target direction within the tolerance, in chronological order
+*/
+func GetBodyAlignments(from time.Time, until time.Time, body Body, observer GeographicCoordinate, target HorizontalCoordinate, tolerance float64, ephemeris Ephemeris) ([]Alignment, error) {
+	var step = 5 * time.Minute
+
+	alignments := []Alignment{}
+
+	var samples [3]Alignment
+
+	for i, t := 0, from; !t.After(until.Add(step)); i, t = i+1, t.Add(step) {
+		a, err := getAlignment(t, body, observer, target, ephemeris)
+
+		if err != nil {
+			return nil, err
+		}
+
+		samples[0], samples[1], samples[2] = samples[1], samples[2], a
+
+		// the middle sample is a local minimum of the separation, and so brackets the closest approach:
+		if i < 2 || samples[1].Separation > samples[0].Separation || samples[1].Separation >= samples[2].Separation {
+			continue
+		}
+
+		closest, err := getClosestAlignment(samples[0].Datetime, samples[2].Datetime, body, observer, target, ephemeris)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if closest.Separation > tolerance || closest.Datetime.Before(from) || closest.Datetime.After(until) {
+			continue
+		}
+
+		if math.IsNaN(target.Altitude) && closest.Altitude < 0 {
+			continue
+		}
+
+		alignments = append(alignments, closest)
+	}
+
+	return alignments, nil
+}
+
+/*
+	getClosestAlignment()
+
+	@param from - the datetime of the start of the bracket (in UTC)
+	@param until - the datetime of the end of the bracket (in UTC)
+	@param body - the body of type Body, e.g., BodySun
+	@param observer - the geographic coordinate of the observer
+	@param target - the horizontal coordinate of the target direction, where the altitude is NaN for any altitude
+	@param ephemeris - the ephemeris backend, e.g., VSOP87Ephemeris{}
+	@returns the alignment of least separation within the bracket, by a golden section search to the second
+*/
+func getClosestAlignment(from time.Time, until time.Time, body Body, observer GeographicCoordinate, target HorizontalCoordinate, ephemeris Ephemeris) (Alignment, error) {
+	var φ = (math.Sqrt(5) - 1) / 2
+
+	var a, b = from, until
+
+	for b.Sub(a) > time.Second {
+		var span = float64(b.Sub(a))
+
+		var c = b.Add(-time.Duration(span * φ))
+
+		var d = a.Add(time.Duration(span * φ))
+
+		ac, err := getAlignment(c, body, observer, target, ephemeris)
+
+		if err != nil {
+			return Alignment{}, err
+		}
+
+		ad, err := getAlignment(d, body, observer, target, ephemeris)
+
+		if err != nil {
+			return Alignment{}, err
+		}
+
+		if ac.Separation < ad.Separation {
+			b = d
+		} else {
+			a = c
+		}
+	}
+
+	return getAlignment(a.Add(b.Sub(a)/2), body, observer, target, ephemeris)
+}
+
+/*
+	GetBodyLandmarkAlignments()
+
+	@param from - the datetime of the start of the search (in UTC)
+	@param until - the datetime of the end of the search (in UTC)
+	@param body - the body of type Body, e.g., BodyMoon
+	@param observer - the geographic coordinate of the observer
+	@param landmark - the geographic coordinate of the landmark, e.g., the summit of a mountain
+	@param tolerance - the maximum angular separation of the body from the landmark (in degrees)
+	@param ephemeris - the ephemeris backend, e.g., VSOP87Ephemeris{}
+	@returns the closest approaches of the body to the landmark within the tolerance, in chronological order
+*/
+func GetBodyLandmarkAlignments(from time.Time, until time.Time, body Body, observer GeographicCoordinate, landmark GeographicCoordinate, tolerance float64, ephemeris Ephemeris) ([]Alignment, error) {
+	target, _ := GetGeodesicBearingAndElevation(observer, landmark)
+
+	return GetBodyAlignments(from, until, body, observer, target, tolerance, ephemeris)
+}
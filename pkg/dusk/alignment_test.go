@@ -0,0 +1,163 @@
+package dusk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGetGeodesicBearingAndElevation(t *testing.T) {
+	var observer = GeographicCoordinate{Latitude: 0, Longitude: 0, Elevation: 0}
+
+	// a target 0.1° due north lies ~11.06km away, and below the horizon due to the curvature of the Earth:
+	got, distance := GetGeodesicBearingAndElevation(observer, GeographicCoordinate{Latitude: 0.1, Longitude: 0, Elevation: 0})
+
+	if math.Abs(got.Azimuth) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.Azimuth, 0.0)
+	}
+
+	if math.Abs(got.Altitude+0.05) > 0.001 {
+		t.Errorf("got %f, wanted %f", got.Altitude, -0.05)
+	}
+
+	if math.Abs(distance-11057.4) > 1 {
+		t.Errorf("got %f, wanted %f", distance, 11057.4)
+	}
+
+	// a 1000m summit ~11.13km due east:
+	got, _ = GetGeodesicBearingAndElevation(observer, GeographicCoordinate{Latitude: 0, Longitude: 0.1, Elevation: 1000})
+
+	if math.Abs(got.Azimuth-90) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.Azimuth, 90.0)
+	}
+
+	// atan(1000 / 11132) less the dip due to the curvature of the Earth:
+	if math.Abs(got.Altitude-(atan2yx(1000, 11132)-0.05)) > 0.005 {
+		t.Errorf("got %f, wanted %f", got.Altitude, atan2yx(1000, 11132)-0.05)
+	}
+}
+
+func TestGetBodyAlignments(t *testing.T) {
+	var observer = GeographicCoordinate{Latitude: spaParameters.Latitude, Longitude: spaParameters.Longitude, Elevation: spaParameters.Elevation}
+
+	// the topocentric zenith angle (50.11162°) and azimuth (194.34024°) of the Sun in the NREL reference case:
+	var target = HorizontalCoordinate{Altitude: 90 - 50.11162, Azimuth: 194.34024}
+
+	got, err := GetBodyAlignments(spaDatetime.Add(-12*time.Hour), spaDatetime.Add(12*time.Hour), BodySun, observer, target, 0.1, VSOP87Ephemeris{})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("got %d alignments, wanted %d", len(got), 1)
+	}
+
+	// the refraction is for standard conditions rather than those of the reference case (820 mbar, 11°C):
+	if math.Abs(got[0].Datetime.Sub(spaDatetime).Seconds()) > 5 || got[0].Separation > 0.01 {
+		t.Errorf("got %v, wanted an alignment at %q", got[0], spaDatetime)
+	}
+}
+
+func TestGetBodyAlignmentsAzimuth(t *testing.T) {
+	var observer = GeographicCoordinate{Latitude: latitude, Longitude: longitude, Elevation: 0}
+
+	// the Sun is due west once a day in mid-May, in the evening, and never due north at Hawaii:
+	var target = HorizontalCoordinate{Altitude: math.NaN(), Azimuth: 270}
+
+	got, err := GetBodyAlignments(time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC), time.Date(2021, 5, 17, 0, 0, 0, 0, time.UTC), BodySun, observer, target, 0.1, VSOP87Ephemeris{})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if len(got) != 3 {
+		t.Fatalf("got %d alignments, wanted %d", len(got), 3)
+	}
+
+	for _, a := range got {
+		if a.Separation > 0.001 || a.Altitude < 0 {
+			t.Errorf("got %v, wanted the Sun due west", a)
+		}
+	}
+
+	target = HorizontalCoordinate{Altitude: math.NaN(), Azimuth: 0}
+
+	got, err = GetBodyAlignments(time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC), time.Date(2021, 5, 17, 0, 0, 0, 0, time.UTC), BodySun, observer, target, 0.1, VSOP87Ephemeris{})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("got %v, wanted no alignments", got)
+	}
+}
+
+func TestGetBodyAlignmentsHorizon(t *testing.T) {
+	var observer = GeographicCoordinate{Latitude: latitude, Longitude: longitude, Elevation: 0}
+
+	sun, err := GetSunriseSunsetTimesInUTCForEphemeris(time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC), 0, longitude, latitude, 0, VSOP87Ephemeris{})
+
+	if err != nil {
+		t.Fatalf("got %q", err)
+	}
+
+	// two minutes after sunrise the geometric altitude of the Sun is still ~-0.4°, although refraction has lifted it into view:
+	var datetime = sun.Rise.Add(2 * time.Minute)
+
+	hz, err := GetSolarHorizontalPositionForEphemeris(datetime, longitude, latitude, VSOP87Ephemeris{})
+
+	if err != nil {
+		t.Fatalf("got %q", err)
+	}
+
+	if hz.Altitude > -0.2 {
+		t.Fatalf("got %f, wanted a geometric altitude below the horizon", hz.Altitude)
+	}
+
+	var target = HorizontalCoordinate{Altitude: math.NaN(), Azimuth: hz.Azimuth}
+
+	got, err := GetBodyAlignments(datetime.Add(-3*time.Hour), datetime.Add(3*time.Hour), BodySun, observer, target, 0.1, VSOP87Ephemeris{})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("got %d alignments, wanted %d", len(got), 1)
+	}
+
+	if math.Abs(got[0].Datetime.Sub(datetime).Seconds()) > 5 || got[0].Altitude < 0 || got[0].Altitude > 0.5 {
+		t.Errorf("got %v, wanted the Sun just above the apparent horizon at %q", got[0], datetime)
+	}
+}
+
+func TestGetBodyLandmarkAlignments(t *testing.T) {
+	var observer = GeographicCoordinate{Latitude: 0, Longitude: 0, Elevation: 0}
+
+	// a 1000m summit ~11.13km due east, at an altitude of ~5.08°:
+	var landmark = GeographicCoordinate{Latitude: 0, Longitude: 0.1, Elevation: 1000}
+
+	got, err := GetBodyLandmarkAlignments(time.Date(2021, 3, 20, 0, 0, 0, 0, time.UTC), time.Date(2021, 3, 21, 0, 0, 0, 0, time.UTC), BodySun, observer, landmark, 0.5, VSOP87Ephemeris{})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("got %d alignments, wanted %d", len(got), 1)
+	}
+
+	// at the equator on the equinox the Sun rises due east at 15° per hour, and so reaches a true altitude of 4.92°
+	// (i.e., less ~0.16° of refraction) at 06:19:41 apparent solar time, or 06:27:10 UTC with an equation of time of -7m29s:
+	var want = time.Date(2021, 3, 20, 6, 27, 10, 0, time.UTC)
+
+	if math.Abs(got[0].Datetime.Sub(want).Seconds()) > 30 {
+		t.Errorf("got %q, wanted %q", got[0].Datetime, want)
+	}
+
+	if math.Abs(got[0].Azimuth-90) > 0.1 || math.Abs(got[0].Altitude-5.08) > 0.01 {
+		t.Errorf("got %v, wanted the Sun at an azimuth of %f and altitude of %f", got[0], 90.0, 5.08)
+	}
+}
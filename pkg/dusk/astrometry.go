@@ -40,3 +40,47 @@ func GetAngularSeparation(coord1 Coordinate, coord2 Coordinate) float64 {
 
 	return atan2yx(math.Sqrt(x*x+y*y), z)
 }
+
+/*
+	GetProperMotionCorrectedEquatorialCoordinate()
+
+	@param eq - the equatorial coordinate { ra, dec } at the starting epoch (in degrees)
+	@param μα - the proper motion in right ascension, μα* = μα cos δ (in milliarcseconds per year)
+	@param μδ - the proper motion in declination (in milliarcseconds per year)
+	@param years - the number of Julian years elapsed since the starting epoch
+	@returns the equatorial coordinate { ra, dec } with the proper motion applied (in degrees), referred to the same equinox
+	@see ch.20 p.125 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetProperMotionCorrectedEquatorialCoordinate(eq EquatorialCoordinate, μα float64, μδ float64, years float64) EquatorialCoordinate {
+	if μα == 0 && μδ == 0 {
+		return eq
+	}
+
+	var sα, cα = sincosx(eq.RightAscension)
+
+	var sδ, cδ = sincosx(eq.Declination)
+
+	// the proper motion displacements (in radians), applied along the local east and north unit vectors so that
+	// objects close to the celestial poles are handled correctly:
+	var Δα = μα / 3600000 * degToRad * years
+
+	var Δδ = μδ / 3600000 * degToRad * years
+
+	var x = cδ*cα - Δα*sα - Δδ*sδ*cα
+
+	var y = cδ*sα + Δα*cα - Δδ*sδ*sα
+
+	var z = sδ + Δδ*cδ
+
+	var α = atan2yx(y, x)
+
+	// correct for negative angles
+	if α < 0 {
+		α += 360
+	}
+
+	return EquatorialCoordinate{
+		RightAscension: α,
+		Declination:    atan2yx(z, math.Sqrt(x*x+y*y)),
+	}
+}
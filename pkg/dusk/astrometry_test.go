@@ -78,3 +78,23 @@ func TestGetAngularSeparationZero(t *testing.T) {
 		t.Errorf("got %f, wanted %f", got, want)
 	}
 }
+
+func TestGetProperMotionCorrectedEquatorialCoordinateThetaPersei(t *testing.T) {
+	// see example 21.b p.128 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	var eq = EquatorialCoordinate{RightAscension: 41.049942, Declination: 49.228467}
+
+	// the proper motion of +0.03425s/yr in right ascension, as μα* = μα cos δ (in milliarcseconds per year):
+	var μα float64 = 0.03425 * 15 * 1000 * cosx(eq.Declination)
+
+	var got = GetProperMotionCorrectedEquatorialCoordinate(eq, μα, -89.5, 28.86705)
+
+	var want = EquatorialCoordinate{RightAscension: 41.054063, Declination: 49.227750}
+
+	if math.Abs(got.RightAscension-want.RightAscension) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got.RightAscension, want.RightAscension)
+	}
+
+	if math.Abs(got.Declination-want.Declination) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got.Declination, want.Declination)
+	}
+}
@@ -0,0 +1,302 @@
+package dusk
+
+import (
+	"math"
+	"time"
+)
+
+type Calendar int
+
+const (
+	/*
+		HistoricalCalendar - the Julian calendar up to 1582 October 4, and the Gregorian calendar from 1582 October 15
+	*/
+	HistoricalCalendar = Calendar(iota)
+	/*
+		GregorianCalendar - the proleptic Gregorian calendar (as used by time.Time)
+	*/
+	GregorianCalendar
+	/*
+		JulianCalendar - the proleptic Julian calendar
+	*/
+	JulianCalendar
+)
+
+// the Julian date of the Gregorian calendar reform, i.e., 1582 October 15 00:00:00 (the day after 1582 October 4 in the Julian calendar):
+var GREGORIAN_CALENDAR_REFORM float64 = 2299160.5
+
+type CalendarDate struct {
+	/*
+		Year - the year, in astronomical year numbering i.e., the year 1 BCE is the year 0, 2 BCE is -1, etc.
+	*/
+	Year int `json:"year"`
+	/*
+		Month - the month of the year, between [1, 12]
+	*/
+	Month int `json:"month"`
+	/*
+		Day - the day of the month, including the fraction of the day e.g., 4.81
+	*/
+	Day float64 `json:"day"`
+	/*
+		Calendar - the calendar in which the date is expressed
+	*/
+	Calendar Calendar `json:"calendar"`
+}
+
+/*
+	ConvertBCEYearToAstronomicalYear()
+
+	@param year - the historical year BCE (or BC), e.g., 585 for 585 BCE
+	@returns the year in astronomical year numbering, e.g., -584
+	@see p.60 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func ConvertBCEYearToAstronomicalYear(year int) int {
+	return 1 - year
+}
+
+/*
+	ConvertAstronomicalYearToHistoricalYear()
+
+	@param year - the year in astronomical year numbering, e.g., -584
+	@returns the historical year, e.g., 585, and whether the year is BCE (or BC)
+	@see p.60 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func ConvertAstronomicalYearToHistoricalYear(year int) (int, bool) {
+	if year > 0 {
+		return year, false
+	}
+
+	return 1 - year, true
+}
+
+/*
+	IsLeapYear()
+
+	@param year - the year, in astronomical year numbering
+	@param calendar - the calendar in which the year is expressed
+	@returns whether the year is a leap year in the calendar
+	@see p.62 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func IsLeapYear(year int, calendar Calendar) bool {
+	// the years are divisible by four in the Julian calendar, including negative years:
+	var julian = ((year%4)+4)%4 == 0
+
+	if calendar == JulianCalendar || (calendar == HistoricalCalendar && year < 1583) {
+		return julian
+	}
+
+	return julian && (year%100 != 0 || year%400 == 0)
+}
+
+/*
+	GetJulianDateFromCalendarDate()
+
+	N.B. unlike GetJulianDate(), which is always in the proleptic Gregorian calendar, this is valid for any date in either
+	calendar, including years BCE (in astronomical year numbering) and negative Julian dates.
+
+	@param date - the calendar date (in UT)
+	@returns the Julian date
+	@see eq.7.1 p.61 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetJulianDateFromCalendarDate(date CalendarDate) float64 {
+	var Y = float64(date.Year)
+
+	var M = float64(date.Month)
+
+	// January and February are considered the 13th and 14th months of the preceding year:
+	if date.Month <= 2 {
+		Y -= 1
+		M += 12
+	}
+
+	var A = math.Floor(Y / 100)
+
+	var B = 2 - A + math.Floor(A/4)
+
+	// dates before 1582 October 15 are interpreted in the Julian calendar:
+	var julian = date.Year < 1582 || (date.Year == 1582 && (date.Month < 10 || (date.Month == 10 && date.Day < 15)))
+
+	if date.Calendar == JulianCalendar || (date.Calendar == HistoricalCalendar && julian) {
+		B = 0
+	}
+
+	return math.Floor(365.25*(Y+4716)) + math.Floor(30.6001*(M+1)) + date.Day + B - 1524.5
+}
+
+/*
+	GetCalendarDateFromJulianDate()
+
+	@param JD - the Julian date
+	@param calendar - the calendar in which to express the date
+	@returns the calendar date (in UT), with the year in astronomical year numbering
+	@see p.63 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetCalendarDateFromJulianDate(JD float64, calendar Calendar) CalendarDate {
+	var Z = math.Floor(JD + 0.5)
+
+	var F = JD + 0.5 - Z
+
+	var A = Z
+
+	if calendar == HistoricalCalendar {
+		calendar = GregorianCalendar
+
+		if JD < GREGORIAN_CALENDAR_REFORM {
+			calendar = JulianCalendar
+		}
+	}
+
+	if calendar == GregorianCalendar {
+		var α = math.Floor((Z - 1867216.25) / 36524.25)
+
+		A = Z + 1 + α - math.Floor(α/4)
+	}
+
+	var B = A + 1524
+
+	var C = math.Floor((B - 122.1) / 365.25)
+
+	var D = math.Floor(365.25 * C)
+
+	var E = math.Floor((B - D) / 30.6001)
+
+	var M = E - 1
+
+	if E >= 14 {
+		M = E - 13
+	}
+
+	var Y = C - 4716
+
+	if M <= 2 {
+		Y = C - 4715
+	}
+
+	return CalendarDate{
+		Year:     int(Y),
+		Month:    int(M),
+		Day:      B - D - math.Floor(30.6001*E) + F,
+		Calendar: calendar,
+	}
+}
+
+/*
+	GetDatetimeFromCalendarDate()
+
+	@param date - the calendar date (in UT), in any calendar
+	@returns the datetime (in UTC), i.e., the same instant in the proleptic Gregorian calendar of time.Time
+*/
+func GetDatetimeFromCalendarDate(date CalendarDate) time.Time {
+	return GetUniversalTime(GetJulianDateFromCalendarDate(date))
+}
+
+/*
+	GetDayOfWeek()
+
+	@param JD - the Julian date
+	@returns the day of the week, which is independent of the calendar
+	@see p.65 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetDayOfWeek(JD float64) time.Weekday {
+	var d = math.Mod(math.Floor(JD+1.5), 7)
+
+	// correct for negative Julian dates
+	if d < 0 {
+		d += 7
+	}
+
+	return time.Weekday(d)
+}
+
+/*
+	GetDayOfYear()
+
+	@param date - the calendar date
+	@returns the day of the year, between [1, 366]
+	@see p.65 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetDayOfYear(date CalendarDate) int {
+	var K = 2
+
+	if IsLeapYear(date.Year, date.Calendar) {
+		K = 1
+	}
+
+	var M = float64(date.Month)
+
+	var N = int(math.Floor(275*M/9)) - K*int(math.Floor((M+9)/12)) + int(math.Floor(date.Day)) - 30
+
+	return N
+}
+
+/*
+	GetEaster()
+
+	N.B. the Gregorian Easter is valid from 1583 onwards; in the HistoricalCalendar, the Julian Easter is returned before 1583.
+
+	@param year - the year, in astronomical year numbering
+	@param calendar - the calendar (and so the rules) of the Easter computus
+	@returns the date of Easter Sunday, in the calendar
+	@see ch.8 p.67-69 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetEaster(year int, calendar Calendar) CalendarDate {
+	if calendar == HistoricalCalendar {
+		calendar = GregorianCalendar
+
+		if year < 1583 {
+			calendar = JulianCalendar
+		}
+	}
+
+	if calendar == JulianCalendar {
+		// the Julian computus holds for all years, including negative years:
+		var a = ((year % 4) + 4) % 4
+
+		var b = ((year % 7) + 7) % 7
+
+		var c = ((year % 19) + 19) % 19
+
+		var d = (19*c + 15) % 30
+
+		var e = (2*a + 4*b - d + 34) % 7
+
+		return CalendarDate{
+			Year:     year,
+			Month:    (d + e + 114) / 31,
+			Day:      float64((d+e+114)%31 + 1),
+			Calendar: JulianCalendar,
+		}
+	}
+
+	var a = year % 19
+
+	var b = year / 100
+
+	var c = year % 100
+
+	var d = b / 4
+
+	var e = b % 4
+
+	var f = (b + 8) / 25
+
+	var g = (b - f + 1) / 3
+
+	var h = (19*a + b - d - g + 15) % 30
+
+	var i = c / 4
+
+	var k = c % 4
+
+	var l = (32 + 2*e + 2*i - h - k) % 7
+
+	var m = (a + 11*h + 22*l) / 451
+
+	return CalendarDate{
+		Year:     year,
+		Month:    (h + l - 7*m + 114) / 31,
+		Day:      float64((h+l-7*m+114)%31 + 1),
+		Calendar: GregorianCalendar,
+	}
+}
@@ -0,0 +1,237 @@
+package dusk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestConvertBCEYearToAstronomicalYear(t *testing.T) {
+	var got int = ConvertBCEYearToAstronomicalYear(585)
+
+	var want int = -584
+
+	if got != want {
+		t.Errorf("got %d, wanted %d", got, want)
+	}
+}
+
+func TestConvertAstronomicalYearToHistoricalYear(t *testing.T) {
+	var got, bce = ConvertAstronomicalYearToHistoricalYear(0)
+
+	if got != 1 || !bce {
+		t.Errorf("got %d %v, wanted %d %v", got, bce, 1, true)
+	}
+
+	got, bce = ConvertAstronomicalYearToHistoricalYear(1957)
+
+	if got != 1957 || bce {
+		t.Errorf("got %d %v, wanted %d %v", got, bce, 1957, false)
+	}
+}
+
+func TestIsLeapYear(t *testing.T) {
+	var tests = []struct {
+		year     int
+		calendar Calendar
+		want     bool
+	}{
+		{900, JulianCalendar, true},
+		{900, GregorianCalendar, false},
+		{1236, HistoricalCalendar, true},
+		{1500, HistoricalCalendar, true},
+		{1700, HistoricalCalendar, false},
+		{2000, GregorianCalendar, true},
+		{-4, JulianCalendar, true},
+		{-1, JulianCalendar, false},
+	}
+
+	for _, tt := range tests {
+		if got := IsLeapYear(tt.year, tt.calendar); got != tt.want {
+			t.Errorf("%d: got %v, wanted %v", tt.year, got, tt.want)
+		}
+	}
+}
+
+// the Julian dates of table p.62 of Meeus:
+var calendarDates = []struct {
+	date CalendarDate
+	JD   float64
+}{
+	{CalendarDate{Year: 2000, Month: 1, Day: 1.5, Calendar: GregorianCalendar}, 2451545.0},
+	{CalendarDate{Year: 1987, Month: 1, Day: 27.0, Calendar: GregorianCalendar}, 2446822.5},
+	{CalendarDate{Year: 1988, Month: 6, Day: 19.5, Calendar: GregorianCalendar}, 2447332.0},
+	{CalendarDate{Year: 1900, Month: 1, Day: 1.0, Calendar: GregorianCalendar}, 2415020.5},
+	{CalendarDate{Year: 1600, Month: 12, Day: 31.0, Calendar: GregorianCalendar}, 2305812.5},
+	{CalendarDate{Year: 837, Month: 4, Day: 10.3, Calendar: JulianCalendar}, 2026871.8},
+	{CalendarDate{Year: -123, Month: 12, Day: 31.0, Calendar: JulianCalendar}, 1676496.5},
+	{CalendarDate{Year: -1000, Month: 7, Day: 12.5, Calendar: JulianCalendar}, 1356001.0},
+	{CalendarDate{Year: -1001, Month: 8, Day: 17.9, Calendar: JulianCalendar}, 1355671.4},
+	{CalendarDate{Year: -4712, Month: 1, Day: 1.5, Calendar: JulianCalendar}, 0.0},
+}
+
+func TestGetJulianDateFromCalendarDate(t *testing.T) {
+	for _, tt := range calendarDates {
+		var got = GetJulianDateFromCalendarDate(tt.date)
+
+		if math.Abs(got-tt.JD) > 0.000001 {
+			t.Errorf("got %f, wanted %f", got, tt.JD)
+		}
+	}
+}
+
+func TestGetJulianDateFromCalendarDateSputnik(t *testing.T) {
+	// the launch of Sputnik 1 (see ex.7.a p.61 of Meeus):
+	var got float64 = GetJulianDateFromCalendarDate(CalendarDate{Year: 1957, Month: 10, Day: 4.81, Calendar: HistoricalCalendar})
+
+	var want float64 = 2436116.31
+
+	if math.Abs(got-want) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetJulianDateFromCalendarDateHistorical(t *testing.T) {
+	// 333 January 27, at noon, in the Julian calendar (see ex.7.b p.61 of Meeus):
+	var got float64 = GetJulianDateFromCalendarDate(CalendarDate{Year: 333, Month: 1, Day: 27.5, Calendar: HistoricalCalendar})
+
+	var want float64 = 1842713.0
+
+	if math.Abs(got-want) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+
+	// the day after 1582 October 4 (Julian) is 1582 October 15 (Gregorian):
+	var before = GetJulianDateFromCalendarDate(CalendarDate{Year: 1582, Month: 10, Day: 4, Calendar: HistoricalCalendar})
+
+	var after = GetJulianDateFromCalendarDate(CalendarDate{Year: 1582, Month: 10, Day: 15, Calendar: HistoricalCalendar})
+
+	if after-before != 1 {
+		t.Errorf("got %f, wanted %f", after-before, 1.0)
+	}
+}
+
+func TestGetCalendarDateFromJulianDate(t *testing.T) {
+	for _, tt := range calendarDates {
+		var got = GetCalendarDateFromJulianDate(tt.JD, tt.date.Calendar)
+
+		if got.Year != tt.date.Year || got.Month != tt.date.Month || math.Abs(got.Day-tt.date.Day) > 0.000001 {
+			t.Errorf("got %v, wanted %v", got, tt.date)
+		}
+	}
+}
+
+func TestGetCalendarDateFromJulianDateHistorical(t *testing.T) {
+	// see ex.7.c p.64 of Meeus:
+	var got CalendarDate = GetCalendarDateFromJulianDate(1507900.13, HistoricalCalendar)
+
+	var want CalendarDate = CalendarDate{Year: -584, Month: 5, Day: 28.63, Calendar: JulianCalendar}
+
+	if got.Year != want.Year || got.Month != want.Month || math.Abs(got.Day-want.Day) > 0.000001 || got.Calendar != want.Calendar {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+
+	got = GetCalendarDateFromJulianDate(2436116.31, HistoricalCalendar)
+
+	want = CalendarDate{Year: 1957, Month: 10, Day: 4.81, Calendar: GregorianCalendar}
+
+	if got.Year != want.Year || got.Month != want.Month || math.Abs(got.Day-want.Day) > 0.000001 || got.Calendar != want.Calendar {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+}
+
+func TestGetCalendarDateFromNegativeJulianDate(t *testing.T) {
+	var want CalendarDate = CalendarDate{Year: -5000, Month: 3, Day: 1.25, Calendar: JulianCalendar}
+
+	var got = GetCalendarDateFromJulianDate(GetJulianDateFromCalendarDate(want), JulianCalendar)
+
+	if got.Year != want.Year || got.Month != want.Month || math.Abs(got.Day-want.Day) > 0.000001 {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+}
+
+func TestGetDatetimeFromCalendarDate(t *testing.T) {
+	// 1582 October 4 in the Julian calendar is 1582 October 14 in the proleptic Gregorian calendar:
+	var got time.Time = GetDatetimeFromCalendarDate(CalendarDate{Year: 1582, Month: 10, Day: 4, Calendar: JulianCalendar})
+
+	var want time.Time = time.Date(1582, 10, 14, 0, 0, 0, 0, time.UTC)
+
+	if !got.Equal(want) {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+
+	// the Gregorian calendar agrees with GetJulianDate():
+	got = GetDatetimeFromCalendarDate(CalendarDate{Year: 2021, Month: 5, Day: 14, Calendar: GregorianCalendar})
+
+	if !got.Equal(datetime) {
+		t.Errorf("got %q, wanted %q", got, datetime)
+	}
+}
+
+func TestGetDayOfWeek(t *testing.T) {
+	// 1954 June 30 was a Wednesday (see ex.7.e p.65 of Meeus):
+	var got time.Weekday = GetDayOfWeek(2434923.5)
+
+	var want time.Weekday = time.Wednesday
+
+	if got != want {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+
+	if got := GetDayOfWeek(GetJulianDate(datetime)); got != datetime.Weekday() {
+		t.Errorf("got %v, wanted %v", got, datetime.Weekday())
+	}
+
+	// -4712 January 1 (Julian) was a Monday:
+	if got := GetDayOfWeek(-0.5); got != time.Monday {
+		t.Errorf("got %v, wanted %v", got, time.Monday)
+	}
+
+	if got := GetDayOfWeek(-1.5); got != time.Sunday {
+		t.Errorf("got %v, wanted %v", got, time.Sunday)
+	}
+}
+
+func TestGetDayOfYear(t *testing.T) {
+	// see ex.7.f & ex.7.g p.65 of Meeus:
+	if got := GetDayOfYear(CalendarDate{Year: 1978, Month: 11, Day: 14, Calendar: GregorianCalendar}); got != 318 {
+		t.Errorf("got %d, wanted %d", got, 318)
+	}
+
+	if got := GetDayOfYear(CalendarDate{Year: 1988, Month: 4, Day: 22, Calendar: GregorianCalendar}); got != 113 {
+		t.Errorf("got %d, wanted %d", got, 113)
+	}
+}
+
+func TestGetEaster(t *testing.T) {
+	// see p.68 & p.69 of Meeus:
+	var tests = []struct {
+		year     int
+		calendar Calendar
+		month    int
+		day      float64
+	}{
+		{1991, GregorianCalendar, 3, 31},
+		{1992, GregorianCalendar, 4, 19},
+		{1993, GregorianCalendar, 4, 11},
+		{1954, GregorianCalendar, 4, 18},
+		{2000, GregorianCalendar, 4, 23},
+		{1818, GregorianCalendar, 3, 22},
+		{2021, HistoricalCalendar, 4, 4},
+		{179, JulianCalendar, 4, 12},
+		{711, JulianCalendar, 4, 12},
+		{1243, HistoricalCalendar, 4, 12},
+	}
+
+	for _, tt := range tests {
+		var got = GetEaster(tt.year, tt.calendar)
+
+		if got.Month != tt.month || got.Day != tt.day {
+			t.Errorf("%d: got %d-%f, wanted %d-%f", tt.year, got.Month, got.Day, tt.month, tt.day)
+		}
+	}
+
+	if got := GetEaster(1243, HistoricalCalendar); got.Calendar != JulianCalendar {
+		t.Errorf("got %v, wanted %v", got.Calendar, JulianCalendar)
+	}
+}
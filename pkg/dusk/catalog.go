@@ -0,0 +1,606 @@
+package dusk
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type ObjectType string
+
+const (
+	ObjectTypeStar             = ObjectType("star")
+	ObjectTypeDoubleStar       = ObjectType("double star")
+	ObjectTypeAsterism         = ObjectType("asterism")
+	ObjectTypeStarCloud        = ObjectType("star cloud")
+	ObjectTypeOpenCluster      = ObjectType("open cluster")
+	ObjectTypeGlobularCluster  = ObjectType("globular cluster")
+	ObjectTypeNebula           = ObjectType("nebula")
+	ObjectTypeDarkNebula       = ObjectType("dark nebula")
+	ObjectTypePlanetaryNebula  = ObjectType("planetary nebula")
+	ObjectTypeSupernovaRemnant = ObjectType("supernova remnant")
+	ObjectTypeGalaxy           = ObjectType("galaxy")
+	ObjectTypePlanet           = ObjectType("planet")
+	ObjectTypeSun              = ObjectType("sun")
+	ObjectTypeMoon             = ObjectType("moon")
+)
+
+type CatalogObject struct {
+	/*
+		Designation - the primary designation of the object, e.g., "M42", "C14", "Vega" or "Jupiter"
+	*/
+	Designation string `json:"designation"`
+	/*
+		Name - the common name of the object, e.g., "Orion Nebula" (if any)
+	*/
+	Name string `json:"name"`
+	/*
+		Aliases - any alternative designations of the object, e.g., "NGC 1976"
+	*/
+	Aliases []string `json:"aliases"`
+	/*
+		Type - the classification of the object, e.g., galaxy, open cluster or planet
+	*/
+	Type ObjectType `json:"type"`
+	/*
+		Constellation - the IAU abbreviation of the constellation the object lies in (empty for Solar System bodies)
+	*/
+	Constellation string `json:"constellation"`
+	/*
+		Magnitude - the (approximate) apparent visual magnitude (zero for Solar System bodies)
+	*/
+	Magnitude float64 `json:"magnitude"`
+	/*
+		EquatorialCoordinate - the mean position referred to the standard epoch J2000 (zero for Solar System bodies)
+	*/
+	EquatorialCoordinate EquatorialCoordinate `json:"eq"`
+}
+
+/*
+	catalogEntry is the compact, embedded representation of a CatalogObject where the J2000 position is given as a
+	sexagesimal string, e.g., "05 35.4 -05 27" (hours and minutes of right ascension, degrees and minutes of declination).
+*/
+type catalogEntry struct {
+	designation   string
+	name          string
+	aliases       string
+	objectType    ObjectType
+	constellation string
+	magnitude     float64
+	position      string
+}
+
+var catalog []CatalogObject
+
+var catalogIndex map[string]int
+
+func init() {
+	var entries = make([]catalogEntry, 0, len(messierCatalog)+len(caldwellCatalog)+len(brightStarCatalog))
+
+	entries = append(entries, messierCatalog[:]...)
+
+	entries = append(entries, caldwellCatalog[:]...)
+
+	entries = append(entries, brightStarCatalog[:]...)
+
+	catalog = make([]CatalogObject, 0, len(entries)+len(planetNames)+1)
+
+	catalogIndex = make(map[string]int)
+
+	for _, e := range entries {
+		eq, err := ParseEquatorialCoordinate(e.position)
+
+		if err != nil {
+			panic(fmt.Sprintf("dusk: invalid embedded catalog position for %s: %v", e.designation, err))
+		}
+
+		var aliases = []string{}
+
+		if e.aliases != "" {
+			aliases = strings.Split(e.aliases, ",")
+		}
+
+		addCatalogObject(CatalogObject{
+			Designation:          e.designation,
+			Name:                 e.name,
+			Aliases:              aliases,
+			Type:                 e.objectType,
+			Constellation:        e.constellation,
+			Magnitude:            e.magnitude,
+			EquatorialCoordinate: eq,
+		})
+	}
+
+	addCatalogObject(CatalogObject{Designation: "Sun", Name: "Sol", Aliases: []string{}, Type: ObjectTypeSun})
+
+	addCatalogObject(CatalogObject{Designation: "Moon", Name: "Luna", Aliases: []string{}, Type: ObjectTypeMoon})
+
+	for p := Mercury; p <= Neptune; p++ {
+		if p == Earth {
+			continue
+		}
+
+		addCatalogObject(CatalogObject{Designation: p.String(), Aliases: []string{}, Type: ObjectTypePlanet})
+	}
+}
+
+/*
+	addCatalogObject()
+
+	@param obj - the catalog object to add to the embedded catalog and to the name resolution index
+*/
+func addCatalogObject(obj CatalogObject) {
+	catalog = append(catalog, obj)
+
+	var i = len(catalog) - 1
+
+	for _, key := range append([]string{obj.Designation, obj.Name}, obj.Aliases...) {
+		var k = normaliseCatalogName(key)
+
+		// the first object to claim a name keeps it, e.g., "M31" and not the Caldwell object:
+		if _, ok := catalogIndex[k]; k != "" && !ok {
+			catalogIndex[k] = i
+		}
+	}
+}
+
+/*
+	normaliseCatalogName()
+
+	@param name - the name of the object, e.g., "M 42", "Messier 42", "caldwell 14" or "Bode's Galaxy"
+	@returns the normalised lookup key, e.g., "m42", "m42", "c14" or "bodesgalaxy"
+*/
+func normaliseCatalogName(name string) string {
+	var key = strings.ToLower(strings.TrimSpace(name))
+
+	for _, r := range []string{" ", "_", "-", "'", "’", ".", "(", ")"} {
+		key = strings.ReplaceAll(key, r, "")
+	}
+
+	if strings.HasPrefix(key, "messier") {
+		key = "m" + strings.TrimPrefix(key, "messier")
+	}
+
+	if strings.HasPrefix(key, "caldwell") {
+		key = "c" + strings.TrimPrefix(key, "caldwell")
+	}
+
+	// e.g., "M042" and "NGC0224" are equivalent to "M42" and "NGC224":
+	for _, prefix := range []string{"ngc", "ic", "m", "c"} {
+		if strings.HasPrefix(key, prefix) {
+			var digits = strings.TrimLeft(strings.TrimPrefix(key, prefix), "0")
+
+			if digits != "" && strings.Trim(digits, "0123456789") == "" {
+				key = prefix + digits
+			}
+
+			break
+		}
+	}
+
+	return key
+}
+
+/*
+	GetCatalogObjects()
+
+	@returns all of the objects in the embedded catalog; Messier, Caldwell, the bright named stars and the Solar System bodies.
+*/
+func GetCatalogObjects() []CatalogObject {
+	var objects = make([]CatalogObject, len(catalog))
+
+	copy(objects, catalog)
+
+	return objects
+}
+
+/*
+	ResolveCatalogObject()
+
+	@param name - the designation, common name or alias of the object, e.g., "M42", "Vega", "NGC 7000" or "Jupiter"
+	@returns the catalog object, or an error if the name cannot be resolved.
+*/
+func ResolveCatalogObject(name string) (CatalogObject, error) {
+	i, ok := catalogIndex[normaliseCatalogName(name)]
+
+	if !ok {
+		return CatalogObject{}, fmt.Errorf("unable to resolve object %q", name)
+	}
+
+	return catalog[i], nil
+}
+
+/*
+	GetCatalogObjectEquatorialPosition()
+
+	N.B. fixed objects are precessed from J2000 to the mean equinox of date (proper motion is not applied), whereas the
+	Sun, Moon and planets are computed from their respective theories for the given datetime.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param obj - the catalog object
+	@returns the equatorial coordinate { ra, dec } of the object for the given datetime (in degrees)
+*/
+func GetCatalogObjectEquatorialPosition(datetime time.Time, obj CatalogObject) EquatorialCoordinate {
+	switch obj.Type {
+	case ObjectTypeSun:
+		return GetSolarEquatorialPosition(datetime)
+	case ObjectTypeMoon:
+		return ConvertEclipticCoordinateToEquatorial(datetime, GetLunarEclipticPosition(datetime))
+	case ObjectTypePlanet:
+		for p := Mercury; p <= Neptune; p++ {
+			if p.String() == obj.Designation {
+				return GetPlanetEquatorialPosition(datetime, p)
+			}
+		}
+	}
+
+	return ConvertJ2000EquatorialCoordinateToEpochOfDate(datetime, obj.EquatorialCoordinate)
+}
+
+/*
+	GetNamedObjectEquatorialPosition()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param name - the designation, common name or alias of the object, e.g., "M42", "Vega" or "Jupiter"
+	@returns the equatorial coordinate { ra, dec } of the object for the given datetime (in degrees), or an error if the name cannot be resolved.
+*/
+func GetNamedObjectEquatorialPosition(datetime time.Time, name string) (EquatorialCoordinate, error) {
+	obj, err := ResolveCatalogObject(name)
+
+	if err != nil {
+		return EquatorialCoordinate{}, err
+	}
+
+	return GetCatalogObjectEquatorialPosition(datetime, obj), nil
+}
+
+/*
+	GetNamedObjectTransit()
+
+	@param datetime - the time to calculate the rise and set times for
+	@param name - the designation, common name or alias of the object, e.g., "M42", "Vega" or "Jupiter"
+	@param latitude - the latitude of the observer
+	@param longitude - the longitude of the observer
+	@returns a Transit struct which contains the rise, maximum and set times of the object in local time
+*/
+func GetNamedObjectTransit(datetime time.Time, name string, latitude float64, longitude float64) (*Transit, error) {
+	eq, err := GetNamedObjectEquatorialPosition(datetime, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return GetObjectTransit(datetime, eq, latitude, longitude)
+}
+
+/*
+	The Messier catalogue (J2000 mean positions)
+
+	@see Frommert, H. & Kronberg, C. The Messier Catalog. Students for the Exploration and Development of Space (SEDS).
+*/
+var messierCatalog = [...]catalogEntry{
+	{"M1", "Crab Nebula", "NGC 1952", ObjectTypeSupernovaRemnant, "Tau", 8.4, "05 34.5 +22 01"},
+	{"M2", "", "NGC 7089", ObjectTypeGlobularCluster, "Aqr", 6.5, "21 33.5 -00 49"},
+	{"M3", "", "NGC 5272", ObjectTypeGlobularCluster, "CVn", 6.2, "13 42.2 +28 23"},
+	{"M4", "", "NGC 6121", ObjectTypeGlobularCluster, "Sco", 5.6, "16 23.6 -26 32"},
+	{"M5", "", "NGC 5904", ObjectTypeGlobularCluster, "Ser", 5.6, "15 18.6 +02 05"},
+	{"M6", "Butterfly Cluster", "NGC 6405", ObjectTypeOpenCluster, "Sco", 4.2, "17 40.1 -32 13"},
+	{"M7", "Ptolemy Cluster", "NGC 6475", ObjectTypeOpenCluster, "Sco", 3.3, "17 53.9 -34 49"},
+	{"M8", "Lagoon Nebula", "NGC 6523", ObjectTypeNebula, "Sgr", 6.0, "18 03.8 -24 23"},
+	{"M9", "", "NGC 6333", ObjectTypeGlobularCluster, "Oph", 7.7, "17 19.2 -18 31"},
+	{"M10", "", "NGC 6254", ObjectTypeGlobularCluster, "Oph", 6.6, "16 57.1 -04 06"},
+	{"M11", "Wild Duck Cluster", "NGC 6705", ObjectTypeOpenCluster, "Sct", 5.8, "18 51.1 -06 16"},
+	{"M12", "", "NGC 6218", ObjectTypeGlobularCluster, "Oph", 6.7, "16 47.2 -01 57"},
+	{"M13", "Great Hercules Cluster", "NGC 6205", ObjectTypeGlobularCluster, "Her", 5.8, "16 41.7 +36 28"},
+	{"M14", "", "NGC 6402", ObjectTypeGlobularCluster, "Oph", 7.6, "17 37.6 -03 15"},
+	{"M15", "", "NGC 7078", ObjectTypeGlobularCluster, "Peg", 6.2, "21 30.0 +12 10"},
+	{"M16", "Eagle Nebula", "NGC 6611", ObjectTypeNebula, "Ser", 6.0, "18 18.8 -13 47"},
+	{"M17", "Omega Nebula", "NGC 6618", ObjectTypeNebula, "Sgr", 6.0, "18 20.8 -16 11"},
+	{"M18", "", "NGC 6613", ObjectTypeOpenCluster, "Sgr", 7.5, "18 19.9 -17 08"},
+	{"M19", "", "NGC 6273", ObjectTypeGlobularCluster, "Oph", 6.8, "17 02.6 -26 16"},
+	{"M20", "Trifid Nebula", "NGC 6514", ObjectTypeNebula, "Sgr", 6.3, "18 02.6 -23 02"},
+	{"M21", "", "NGC 6531", ObjectTypeOpenCluster, "Sgr", 6.5, "18 04.6 -22 30"},
+	{"M22", "", "NGC 6656", ObjectTypeGlobularCluster, "Sgr", 5.1, "18 36.4 -23 54"},
+	{"M23", "", "NGC 6494", ObjectTypeOpenCluster, "Sgr", 6.9, "17 56.8 -19 01"},
+	{"M24", "Sagittarius Star Cloud", "IC 4715", ObjectTypeStarCloud, "Sgr", 4.6, "18 16.9 -18 29"},
+	{"M25", "", "IC 4725", ObjectTypeOpenCluster, "Sgr", 4.6, "18 31.6 -19 15"},
+	{"M26", "", "NGC 6694", ObjectTypeOpenCluster, "Sct", 8.0, "18 45.2 -09 24"},
+	{"M27", "Dumbbell Nebula", "NGC 6853", ObjectTypePlanetaryNebula, "Vul", 7.5, "19 59.6 +22 43"},
+	{"M28", "", "NGC 6626", ObjectTypeGlobularCluster, "Sgr", 6.8, "18 24.5 -24 52"},
+	{"M29", "", "NGC 6913", ObjectTypeOpenCluster, "Cyg", 7.1, "20 23.9 +38 32"},
+	{"M30", "", "NGC 7099", ObjectTypeGlobularCluster, "Cap", 7.2, "21 40.4 -23 11"},
+	{"M31", "Andromeda Galaxy", "NGC 224", ObjectTypeGalaxy, "And", 3.4, "00 42.7 +41 16"},
+	{"M32", "", "NGC 221", ObjectTypeGalaxy, "And", 8.1, "00 42.7 +40 52"},
+	{"M33", "Triangulum Galaxy", "NGC 598", ObjectTypeGalaxy, "Tri", 5.7, "01 33.9 +30 39"},
+	{"M34", "", "NGC 1039", ObjectTypeOpenCluster, "Per", 5.5, "02 42.0 +42 47"},
+	{"M35", "", "NGC 2168", ObjectTypeOpenCluster, "Gem", 5.3, "06 08.9 +24 20"},
+	{"M36", "Pinwheel Cluster", "NGC 1960", ObjectTypeOpenCluster, "Aur", 6.3, "05 36.1 +34 08"},
+	{"M37", "", "NGC 2099", ObjectTypeOpenCluster, "Aur", 6.2, "05 52.4 +32 33"},
+	{"M38", "Starfish Cluster", "NGC 1912", ObjectTypeOpenCluster, "Aur", 7.4, "05 28.4 +35 50"},
+	{"M39", "", "NGC 7092", ObjectTypeOpenCluster, "Cyg", 4.6, "21 32.2 +48 26"},
+	{"M40", "Winnecke 4", "", ObjectTypeDoubleStar, "UMa", 8.4, "12 22.4 +58 05"},
+	{"M41", "", "NGC 2287", ObjectTypeOpenCluster, "CMa", 4.5, "06 46.0 -20 44"},
+	{"M42", "Orion Nebula", "NGC 1976", ObjectTypeNebula, "Ori", 4.0, "05 35.4 -05 27"},
+	{"M43", "De Mairan's Nebula", "NGC 1982", ObjectTypeNebula, "Ori", 9.0, "05 35.6 -05 16"},
+	{"M44", "Beehive Cluster", "NGC 2632,Praesepe", ObjectTypeOpenCluster, "Cnc", 3.7, "08 40.1 +19 59"},
+	{"M45", "Pleiades", "Seven Sisters", ObjectTypeOpenCluster, "Tau", 1.6, "03 47.0 +24 07"},
+	{"M46", "", "NGC 2437", ObjectTypeOpenCluster, "Pup", 6.1, "07 41.8 -14 49"},
+	{"M47", "", "NGC 2422", ObjectTypeOpenCluster, "Pup", 4.2, "07 36.6 -14 30"},
+	{"M48", "", "NGC 2548", ObjectTypeOpenCluster, "Hya", 5.8, "08 13.8 -05 48"},
+	{"M49", "", "NGC 4472", ObjectTypeGalaxy, "Vir", 8.4, "12 29.8 +08 00"},
+	{"M50", "", "NGC 2323", ObjectTypeOpenCluster, "Mon", 6.3, "07 03.2 -08 20"},
+	{"M51", "Whirlpool Galaxy", "NGC 5194", ObjectTypeGalaxy, "CVn", 8.4, "13 29.9 +47 12"},
+	{"M52", "", "NGC 7654", ObjectTypeOpenCluster, "Cas", 7.3, "23 24.2 +61 35"},
+	{"M53", "", "NGC 5024", ObjectTypeGlobularCluster, "Com", 7.6, "13 12.9 +18 10"},
+	{"M54", "", "NGC 6715", ObjectTypeGlobularCluster, "Sgr", 7.6, "18 55.1 -30 29"},
+	{"M55", "", "NGC 6809", ObjectTypeGlobularCluster, "Sgr", 6.3, "19 40.0 -30 58"},
+	{"M56", "", "NGC 6779", ObjectTypeGlobularCluster, "Lyr", 8.3, "19 16.6 +30 11"},
+	{"M57", "Ring Nebula", "NGC 6720", ObjectTypePlanetaryNebula, "Lyr", 8.8, "18 53.6 +33 02"},
+	{"M58", "", "NGC 4579", ObjectTypeGalaxy, "Vir", 9.7, "12 37.7 +11 49"},
+	{"M59", "", "NGC 4621", ObjectTypeGalaxy, "Vir", 9.6, "12 42.0 +11 39"},
+	{"M60", "", "NGC 4649", ObjectTypeGalaxy, "Vir", 8.8, "12 43.7 +11 33"},
+	{"M61", "", "NGC 4303", ObjectTypeGalaxy, "Vir", 9.7, "12 21.9 +04 28"},
+	{"M62", "", "NGC 6266", ObjectTypeGlobularCluster, "Oph", 6.5, "17 01.2 -30 07"},
+	{"M63", "Sunflower Galaxy", "NGC 5055", ObjectTypeGalaxy, "CVn", 8.6, "13 15.8 +42 02"},
+	{"M64", "Black Eye Galaxy", "NGC 4826", ObjectTypeGalaxy, "Com", 8.5, "12 56.7 +21 41"},
+	{"M65", "", "NGC 3623", ObjectTypeGalaxy, "Leo", 9.3, "11 18.9 +13 05"},
+	{"M66", "", "NGC 3627", ObjectTypeGalaxy, "Leo", 8.9, "11 20.2 +12 59"},
+	{"M67", "", "NGC 2682", ObjectTypeOpenCluster, "Cnc", 6.1, "08 50.4 +11 49"},
+	{"M68", "", "NGC 4590", ObjectTypeGlobularCluster, "Hya", 7.8, "12 39.5 -26 45"},
+	{"M69", "", "NGC 6637", ObjectTypeGlobularCluster, "Sgr", 7.6, "18 31.4 -32 21"},
+	{"M70", "", "NGC 6681", ObjectTypeGlobularCluster, "Sgr", 7.9, "18 43.2 -32 18"},
+	{"M71", "", "NGC 6838", ObjectTypeGlobularCluster, "Sge", 8.2, "19 53.8 +18 47"},
+	{"M72", "", "NGC 6981", ObjectTypeGlobularCluster, "Aqr", 9.3, "20 53.5 -12 32"},
+	{"M73", "", "NGC 6994", ObjectTypeAsterism, "Aqr", 9.0, "20 58.9 -12 38"},
+	{"M74", "Phantom Galaxy", "NGC 628", ObjectTypeGalaxy, "Psc", 9.4, "01 36.7 +15 47"},
+	{"M75", "", "NGC 6864", ObjectTypeGlobularCluster, "Sgr", 8.5, "20 06.1 -21 55"},
+	{"M76", "Little Dumbbell Nebula", "NGC 650,NGC 651", ObjectTypePlanetaryNebula, "Per", 10.1, "01 42.4 +51 34"},
+	{"M77", "Cetus A", "NGC 1068", ObjectTypeGalaxy, "Cet", 8.9, "02 42.7 -00 01"},
+	{"M78", "", "NGC 2068", ObjectTypeNebula, "Ori", 8.3, "05 46.7 +00 03"},
+	{"M79", "", "NGC 1904", ObjectTypeGlobularCluster, "Lep", 7.7, "05 24.5 -24 33"},
+	{"M80", "", "NGC 6093", ObjectTypeGlobularCluster, "Sco", 7.3, "16 17.0 -22 59"},
+	{"M81", "Bode's Galaxy", "NGC 3031", ObjectTypeGalaxy, "UMa", 6.9, "09 55.6 +69 04"},
+	{"M82", "Cigar Galaxy", "NGC 3034", ObjectTypeGalaxy, "UMa", 8.4, "09 55.8 +69 41"},
+	{"M83", "Southern Pinwheel Galaxy", "NGC 5236", ObjectTypeGalaxy, "Hya", 7.6, "13 37.0 -29 52"},
+	{"M84", "", "NGC 4374", ObjectTypeGalaxy, "Vir", 9.1, "12 25.1 +12 53"},
+	{"M85", "", "NGC 4382", ObjectTypeGalaxy, "Com", 9.1, "12 25.4 +18 11"},
+	{"M86", "", "NGC 4406", ObjectTypeGalaxy, "Vir", 8.9, "12 26.2 +12 57"},
+	{"M87", "Virgo A", "NGC 4486", ObjectTypeGalaxy, "Vir", 8.6, "12 30.8 +12 23"},
+	{"M88", "", "NGC 4501", ObjectTypeGalaxy, "Com", 9.6, "12 32.0 +14 25"},
+	{"M89", "", "NGC 4552", ObjectTypeGalaxy, "Vir", 9.8, "12 35.7 +12 33"},
+	{"M90", "", "NGC 4569", ObjectTypeGalaxy, "Vir", 9.5, "12 36.8 +13 10"},
+	{"M91", "", "NGC 4548", ObjectTypeGalaxy, "Com", 10.2, "12 35.4 +14 30"},
+	{"M92", "", "NGC 6341", ObjectTypeGlobularCluster, "Her", 6.4, "17 17.1 +43 08"},
+	{"M93", "", "NGC 2447", ObjectTypeOpenCluster, "Pup", 6.0, "07 44.6 -23 52"},
+	{"M94", "Cat's Eye Galaxy", "NGC 4736", ObjectTypeGalaxy, "CVn", 8.2, "12 50.9 +41 07"},
+	{"M95", "", "NGC 3351", ObjectTypeGalaxy, "Leo", 9.7, "10 44.0 +11 42"},
+	{"M96", "", "NGC 3368", ObjectTypeGalaxy, "Leo", 9.2, "10 46.8 +11 49"},
+	{"M97", "Owl Nebula", "NGC 3587", ObjectTypePlanetaryNebula, "UMa", 9.9, "11 14.8 +55 01"},
+	{"M98", "", "NGC 4192", ObjectTypeGalaxy, "Com", 10.1, "12 13.8 +14 54"},
+	{"M99", "", "NGC 4254", ObjectTypeGalaxy, "Com", 9.9, "12 18.8 +14 25"},
+	{"M100", "", "NGC 4321", ObjectTypeGalaxy, "Com", 9.3, "12 22.9 +15 49"},
+	{"M101", "Pinwheel Galaxy", "NGC 5457", ObjectTypeGalaxy, "UMa", 7.9, "14 03.2 +54 21"},
+	{"M102", "Spindle Galaxy", "NGC 5866", ObjectTypeGalaxy, "Dra", 9.9, "15 06.5 +55 46"},
+	{"M103", "", "NGC 581", ObjectTypeOpenCluster, "Cas", 7.4, "01 33.2 +60 42"},
+	{"M104", "Sombrero Galaxy", "NGC 4594", ObjectTypeGalaxy, "Vir", 8.0, "12 40.0 -11 37"},
+	{"M105", "", "NGC 3379", ObjectTypeGalaxy, "Leo", 9.3, "10 47.8 +12 35"},
+	{"M106", "", "NGC 4258", ObjectTypeGalaxy, "CVn", 8.4, "12 19.0 +47 18"},
+	{"M107", "", "NGC 6171", ObjectTypeGlobularCluster, "Oph", 7.9, "16 32.5 -13 03"},
+	{"M108", "Surfboard Galaxy", "NGC 3556", ObjectTypeGalaxy, "UMa", 10.0, "11 11.5 +55 40"},
+	{"M109", "", "NGC 3992", ObjectTypeGalaxy, "UMa", 9.8, "11 57.6 +53 23"},
+	{"M110", "", "NGC 205", ObjectTypeGalaxy, "And", 8.5, "00 40.4 +41 41"},
+}
+
+/*
+	The Caldwell catalogue (J2000 mean positions)
+
+	@see Moore, P. 1995. The Caldwell Catalogue. Sky & Telescope, December 1995.
+*/
+var caldwellCatalog = [...]catalogEntry{
+	{"C1", "", "NGC 188", ObjectTypeOpenCluster, "Cep", 8.1, "00 44.4 +85 20"},
+	{"C2", "Bow-Tie Nebula", "NGC 40", ObjectTypePlanetaryNebula, "Cep", 11.4, "00 13.0 +72 32"},
+	{"C3", "", "NGC 4236", ObjectTypeGalaxy, "Dra", 9.7, "12 16.7 +69 28"},
+	{"C4", "Iris Nebula", "NGC 7023", ObjectTypeNebula, "Cep", 6.8, "21 01.8 +68 12"},
+	{"C5", "Hidden Galaxy", "IC 342", ObjectTypeGalaxy, "Cam", 9.2, "03 46.8 +68 06"},
+	{"C6", "Cat's Eye Nebula", "NGC 6543", ObjectTypePlanetaryNebula, "Dra", 8.1, "17 58.6 +66 38"},
+	{"C7", "", "NGC 2403", ObjectTypeGalaxy, "Cam", 8.4, "07 36.9 +65 36"},
+	{"C8", "", "NGC 559", ObjectTypeOpenCluster, "Cas", 9.5, "01 29.5 +63 18"},
+	{"C9", "Cave Nebula", "Sh2-155", ObjectTypeNebula, "Cep", 7.7, "22 56.8 +62 37"},
+	{"C10", "", "NGC 663", ObjectTypeOpenCluster, "Cas", 7.1, "01 46.0 +61 15"},
+	{"C11", "Bubble Nebula", "NGC 7635", ObjectTypeNebula, "Cas", 10.0, "23 20.7 +61 12"},
+	{"C12", "Fireworks Galaxy", "NGC 6946", ObjectTypeGalaxy, "Cep", 8.9, "20 34.8 +60 09"},
+	{"C13", "Owl Cluster", "NGC 457", ObjectTypeOpenCluster, "Cas", 6.4, "01 19.1 +58 20"},
+	{"C14", "Double Cluster", "NGC 869,NGC 884", ObjectTypeOpenCluster, "Per", 4.3, "02 20.0 +57 08"},
+	{"C15", "Blinking Planetary", "NGC 6826", ObjectTypePlanetaryNebula, "Cyg", 9.8, "19 44.8 +50 31"},
+	{"C16", "", "NGC 7243", ObjectTypeOpenCluster, "Lac", 6.4, "22 15.3 +49 53"},
+	{"C17", "", "NGC 147", ObjectTypeGalaxy, "Cas", 9.3, "00 33.2 +48 30"},
+	{"C18", "", "NGC 185", ObjectTypeGalaxy, "Cas", 9.2, "00 39.0 +48 20"},
+	{"C19", "Cocoon Nebula", "IC 5146", ObjectTypeNebula, "Cyg", 10.0, "21 53.5 +47 16"},
+	{"C20", "North America Nebula", "NGC 7000", ObjectTypeNebula, "Cyg", 4.0, "20 58.8 +44 20"},
+	{"C21", "", "NGC 4449", ObjectTypeGalaxy, "CVn", 9.4, "12 28.2 +44 06"},
+	{"C22", "Blue Snowball Nebula", "NGC 7662", ObjectTypePlanetaryNebula, "And", 9.2, "23 25.9 +42 33"},
+	{"C23", "Silver Sliver Galaxy", "NGC 891", ObjectTypeGalaxy, "And", 9.9, "02 22.6 +42 21"},
+	{"C24", "Perseus A", "NGC 1275", ObjectTypeGalaxy, "Per", 11.6, "03 19.8 +41 31"},
+	{"C25", "Intergalactic Wanderer", "NGC 2419", ObjectTypeGlobularCluster, "Lyn", 10.4, "07 38.1 +38 53"},
+	{"C26", "Silver Needle Galaxy", "NGC 4244", ObjectTypeGalaxy, "CVn", 10.2, "12 17.5 +37 49"},
+	{"C27", "Crescent Nebula", "NGC 6888", ObjectTypeNebula, "Cyg", 7.4, "20 12.0 +38 21"},
+	{"C28", "", "NGC 752", ObjectTypeOpenCluster, "And", 5.7, "01 57.8 +37 41"},
+	{"C29", "", "NGC 5005", ObjectTypeGalaxy, "CVn", 9.8, "13 10.9 +37 03"},
+	{"C30", "", "NGC 7331", ObjectTypeGalaxy, "Peg", 9.5, "22 37.1 +34 25"},
+	{"C31", "Flaming Star Nebula", "IC 405", ObjectTypeNebula, "Aur", 6.0, "05 16.2 +34 16"},
+	{"C32", "Whale Galaxy", "NGC 4631", ObjectTypeGalaxy, "CVn", 9.3, "12 42.1 +32 32"},
+	{"C33", "Eastern Veil Nebula", "NGC 6992", ObjectTypeSupernovaRemnant, "Cyg", 7.0, "20 56.4 +31 43"},
+	{"C34", "Western Veil Nebula", "NGC 6960", ObjectTypeSupernovaRemnant, "Cyg", 7.0, "20 45.7 +30 43"},
+	{"C35", "", "NGC 4889", ObjectTypeGalaxy, "Com", 11.4, "13 00.1 +27 59"},
+	{"C36", "", "NGC 4559", ObjectTypeGalaxy, "Com", 9.9, "12 36.0 +27 58"},
+	{"C37", "", "NGC 6885", ObjectTypeOpenCluster, "Vul", 5.7, "20 12.0 +26 29"},
+	{"C38", "Needle Galaxy", "NGC 4565", ObjectTypeGalaxy, "Com", 9.6, "12 36.3 +25 59"},
+	{"C39", "Eskimo Nebula", "NGC 2392", ObjectTypePlanetaryNebula, "Gem", 9.2, "07 29.2 +20 55"},
+	{"C40", "", "NGC 3626", ObjectTypeGalaxy, "Leo", 10.9, "11 20.1 +18 21"},
+	{"C41", "Hyades", "Mel 25", ObjectTypeOpenCluster, "Tau", 0.5, "04 27.0 +16 00"},
+	{"C42", "", "NGC 7006", ObjectTypeGlobularCluster, "Del", 10.6, "21 01.5 +16 11"},
+	{"C43", "", "NGC 7814", ObjectTypeGalaxy, "Peg", 10.5, "00 03.3 +16 09"},
+	{"C44", "", "NGC 7479", ObjectTypeGalaxy, "Peg", 11.0, "23 04.9 +12 19"},
+	{"C45", "", "NGC 5248", ObjectTypeGalaxy, "Boo", 10.2, "13 37.5 +08 53"},
+	{"C46", "Hubble's Variable Nebula", "NGC 2261", ObjectTypeNebula, "Mon", 10.0, "06 39.2 +08 44"},
+	{"C47", "", "NGC 6934", ObjectTypeGlobularCluster, "Del", 8.9, "20 34.2 +07 24"},
+	{"C48", "", "NGC 2775", ObjectTypeGalaxy, "Cnc", 10.3, "09 10.3 +07 02"},
+	{"C49", "Rosette Nebula", "NGC 2237", ObjectTypeNebula, "Mon", 9.0, "06 32.3 +05 03"},
+	{"C50", "", "NGC 2244", ObjectTypeOpenCluster, "Mon", 4.8, "06 32.4 +04 52"},
+	{"C51", "", "IC 1613", ObjectTypeGalaxy, "Cet", 9.3, "01 04.8 +02 07"},
+	{"C52", "", "NGC 4697", ObjectTypeGalaxy, "Vir", 9.3, "12 48.6 -05 48"},
+	{"C53", "Spindle Galaxy (NGC 3115)", "NGC 3115", ObjectTypeGalaxy, "Sex", 9.2, "10 05.2 -07 43"},
+	{"C54", "", "NGC 2506", ObjectTypeOpenCluster, "Mon", 7.6, "08 00.2 -10 47"},
+	{"C55", "Saturn Nebula", "NGC 7009", ObjectTypePlanetaryNebula, "Aqr", 8.0, "21 04.2 -11 22"},
+	{"C56", "Skull Nebula", "NGC 246", ObjectTypePlanetaryNebula, "Cet", 8.0, "00 47.0 -11 53"},
+	{"C57", "Barnard's Galaxy", "NGC 6822", ObjectTypeGalaxy, "Sgr", 8.8, "19 44.9 -14 48"},
+	{"C58", "", "NGC 2360", ObjectTypeOpenCluster, "CMa", 7.2, "07 17.8 -15 37"},
+	{"C59", "Ghost of Jupiter", "NGC 3242", ObjectTypePlanetaryNebula, "Hya", 8.6, "10 24.8 -18 38"},
+	{"C60", "Antennae Galaxies", "NGC 4038", ObjectTypeGalaxy, "Crv", 10.7, "12 01.9 -18 52"},
+	{"C61", "", "NGC 4039", ObjectTypeGalaxy, "Crv", 13.0, "12 01.9 -18 53"},
+	{"C62", "", "NGC 247", ObjectTypeGalaxy, "Cet", 8.9, "00 47.1 -20 46"},
+	{"C63", "Helix Nebula", "NGC 7293", ObjectTypePlanetaryNebula, "Aqr", 7.3, "22 29.6 -20 48"},
+	{"C64", "Tau Canis Majoris Cluster", "NGC 2362", ObjectTypeOpenCluster, "CMa", 4.1, "07 18.8 -24 57"},
+	{"C65", "Sculptor Galaxy", "NGC 253", ObjectTypeGalaxy, "Scl", 7.1, "00 47.6 -25 17"},
+	{"C66", "", "NGC 5694", ObjectTypeGlobularCluster, "Hya", 10.2, "14 39.6 -26 32"},
+	{"C67", "", "NGC 1097", ObjectTypeGalaxy, "For", 9.2, "02 46.3 -30 17"},
+	{"C68", "R Coronae Australis Nebula", "NGC 6729", ObjectTypeNebula, "CrA", 9.7, "19 01.9 -36 57"},
+	{"C69", "Bug Nebula", "NGC 6302", ObjectTypePlanetaryNebula, "Sco", 12.8, "17 13.7 -37 06"},
+	{"C70", "", "NGC 300", ObjectTypeGalaxy, "Scl", 8.1, "00 54.9 -37 41"},
+	{"C71", "", "NGC 2477", ObjectTypeOpenCluster, "Pup", 5.8, "07 52.3 -38 33"},
+	{"C72", "", "NGC 55", ObjectTypeGalaxy, "Scl", 8.2, "00 14.9 -39 11"},
+	{"C73", "", "NGC 1851", ObjectTypeGlobularCluster, "Col", 7.3, "05 14.1 -40 03"},
+	{"C74", "Eight-Burst Nebula", "NGC 3132", ObjectTypePlanetaryNebula, "Vel", 9.4, "10 07.7 -40 26"},
+	{"C75", "", "NGC 6124", ObjectTypeOpenCluster, "Sco", 5.8, "16 25.6 -40 40"},
+	{"C76", "", "NGC 6231", ObjectTypeOpenCluster, "Sco", 2.6, "16 54.0 -41 48"},
+	{"C77", "Centaurus A", "NGC 5128", ObjectTypeGalaxy, "Cen", 7.0, "13 25.5 -43 01"},
+	{"C78", "", "NGC 6541", ObjectTypeGlobularCluster, "CrA", 6.6, "18 08.0 -43 42"},
+	{"C79", "", "NGC 3201", ObjectTypeGlobularCluster, "Vel", 6.7, "10 17.6 -46 25"},
+	{"C80", "Omega Centauri", "NGC 5139", ObjectTypeGlobularCluster, "Cen", 3.6, "13 26.8 -47 29"},
+	{"C81", "", "NGC 6352", ObjectTypeGlobularCluster, "Ara", 8.1, "17 25.5 -48 25"},
+	{"C82", "", "NGC 6193", ObjectTypeOpenCluster, "Ara", 5.2, "16 41.3 -48 46"},
+	{"C83", "", "NGC 4945", ObjectTypeGalaxy, "Cen", 8.7, "13 05.4 -49 28"},
+	{"C84", "", "NGC 5286", ObjectTypeGlobularCluster, "Cen", 7.6, "13 46.4 -51 22"},
+	{"C85", "Omicron Velorum Cluster", "IC 2391", ObjectTypeOpenCluster, "Vel", 2.5, "08 40.2 -53 04"},
+	{"C86", "", "NGC 6397", ObjectTypeGlobularCluster, "Ara", 5.7, "17 40.7 -53 40"},
+	{"C87", "", "NGC 1261", ObjectTypeGlobularCluster, "Hor", 8.4, "03 12.3 -55 13"},
+	{"C88", "", "NGC 5823", ObjectTypeOpenCluster, "Cir", 7.9, "15 05.7 -55 36"},
+	{"C89", "S Normae Cluster", "NGC 6087", ObjectTypeOpenCluster, "Nor", 5.4, "16 18.9 -57 54"},
+	{"C90", "", "NGC 2867", ObjectTypePlanetaryNebula, "Car", 9.7, "09 21.4 -58 19"},
+	{"C91", "Wishing Well Cluster", "NGC 3532", ObjectTypeOpenCluster, "Car", 3.0, "11 06.4 -58 40"},
+	{"C92", "Eta Carinae Nebula", "NGC 3372,Carina Nebula", ObjectTypeNebula, "Car", 3.0, "10 43.8 -59 52"},
+	{"C93", "", "NGC 6752", ObjectTypeGlobularCluster, "Pav", 5.4, "19 10.9 -59 59"},
+	{"C94", "Jewel Box", "NGC 4755", ObjectTypeOpenCluster, "Cru", 4.2, "12 53.6 -60 20"},
+	{"C95", "", "NGC 6025", ObjectTypeOpenCluster, "TrA", 5.1, "16 03.7 -60 30"},
+	{"C96", "", "NGC 2516", ObjectTypeOpenCluster, "Car", 3.8, "07 58.3 -60 52"},
+	{"C97", "Pearl Cluster", "NGC 3766", ObjectTypeOpenCluster, "Cen", 5.3, "11 36.1 -61 37"},
+	{"C98", "", "NGC 4609", ObjectTypeOpenCluster, "Cru", 6.9, "12 42.3 -62 58"},
+	{"C99", "Coalsack Nebula", "", ObjectTypeDarkNebula, "Cru", 0, "12 50.0 -62 30"},
+	{"C100", "Lambda Centauri Nebula", "IC 2944", ObjectTypeNebula, "Cen", 4.5, "11 36.6 -63 02"},
+	{"C101", "", "NGC 6744", ObjectTypeGalaxy, "Pav", 9.0, "19 09.8 -63 51"},
+	{"C102", "Southern Pleiades", "IC 2602", ObjectTypeOpenCluster, "Car", 1.9, "10 43.2 -64 24"},
+	{"C103", "Tarantula Nebula", "NGC 2070", ObjectTypeNebula, "Dor", 1.0, "05 38.7 -69 06"},
+	{"C104", "", "NGC 362", ObjectTypeGlobularCluster, "Tuc", 6.6, "01 03.2 -70 51"},
+	{"C105", "", "NGC 4833", ObjectTypeGlobularCluster, "Mus", 7.3, "12 59.6 -70 53"},
+	{"C106", "47 Tucanae", "NGC 104", ObjectTypeGlobularCluster, "Tuc", 4.0, "00 24.1 -72 05"},
+	{"C107", "", "NGC 6101", ObjectTypeGlobularCluster, "Aps", 9.3, "16 25.8 -72 12"},
+	{"C108", "", "NGC 4372", ObjectTypeGlobularCluster, "Mus", 7.8, "12 25.8 -72 40"},
+	{"C109", "", "NGC 3195", ObjectTypePlanetaryNebula, "Cha", 11.6, "10 09.5 -80 52"},
+}
+
+/*
+	The bright named stars (J2000 mean positions), with the IAU Working Group on Star Names (WGSN) proper names.
+
+	@see The Hipparcos and Tycho Catalogues. 1997. ESA SP-1200.
+*/
+var brightStarCatalog = [...]catalogEntry{
+	{"Sirius", "", "Alpha Canis Majoris,α CMa", ObjectTypeStar, "CMa", -1.46, "06 45 08.92 -16 42 58.0"},
+	{"Canopus", "", "Alpha Carinae,α Car", ObjectTypeStar, "Car", -0.74, "06 23 57.11 -52 41 44.4"},
+	{"Rigil Kentaurus", "", "Alpha Centauri,α Cen,Toliman", ObjectTypeStar, "Cen", -0.27, "14 39 36.49 -60 50 02.3"},
+	{"Arcturus", "", "Alpha Bootis,α Boo", ObjectTypeStar, "Boo", -0.05, "14 15 39.67 +19 10 56.7"},
+	{"Vega", "", "Alpha Lyrae,α Lyr", ObjectTypeStar, "Lyr", 0.03, "18 36 56.34 +38 47 01.3"},
+	{"Capella", "", "Alpha Aurigae,α Aur", ObjectTypeStar, "Aur", 0.08, "05 16 41.36 +45 59 52.8"},
+	{"Rigel", "", "Beta Orionis,β Ori", ObjectTypeStar, "Ori", 0.13, "05 14 32.27 -08 12 05.9"},
+	{"Procyon", "", "Alpha Canis Minoris,α CMi", ObjectTypeStar, "CMi", 0.34, "07 39 18.12 +05 13 30.0"},
+	{"Achernar", "", "Alpha Eridani,α Eri", ObjectTypeStar, "Eri", 0.46, "01 37 42.85 -57 14 12.3"},
+	{"Betelgeuse", "", "Alpha Orionis,α Ori", ObjectTypeStar, "Ori", 0.50, "05 55 10.31 +07 24 25.4"},
+	{"Hadar", "", "Beta Centauri,β Cen,Agena", ObjectTypeStar, "Cen", 0.61, "14 03 49.41 -60 22 22.9"},
+	{"Altair", "", "Alpha Aquilae,α Aql", ObjectTypeStar, "Aql", 0.76, "19 50 47.00 +08 52 06.0"},
+	{"Acrux", "", "Alpha Crucis,α Cru", ObjectTypeStar, "Cru", 0.76, "12 26 35.90 -63 05 56.7"},
+	{"Aldebaran", "", "Alpha Tauri,α Tau", ObjectTypeStar, "Tau", 0.86, "04 35 55.24 +16 30 33.5"},
+	{"Antares", "", "Alpha Scorpii,α Sco", ObjectTypeStar, "Sco", 0.96, "16 29 24.46 -26 25 55.2"},
+	{"Spica", "", "Alpha Virginis,α Vir", ObjectTypeStar, "Vir", 0.97, "13 25 11.58 -11 09 40.8"},
+	{"Pollux", "", "Beta Geminorum,β Gem", ObjectTypeStar, "Gem", 1.14, "07 45 18.95 +28 01 34.3"},
+	{"Fomalhaut", "", "Alpha Piscis Austrini,α PsA", ObjectTypeStar, "PsA", 1.16, "22 57 39.05 -29 37 20.1"},
+	{"Deneb", "", "Alpha Cygni,α Cyg", ObjectTypeStar, "Cyg", 1.25, "20 41 25.92 +45 16 49.2"},
+	{"Mimosa", "", "Beta Crucis,β Cru", ObjectTypeStar, "Cru", 1.25, "12 47 43.27 -59 41 19.6"},
+	{"Regulus", "", "Alpha Leonis,α Leo", ObjectTypeStar, "Leo", 1.40, "10 08 22.31 +11 58 02.0"},
+	{"Adhara", "", "Epsilon Canis Majoris,ε CMa", ObjectTypeStar, "CMa", 1.50, "06 58 37.55 -28 58 19.5"},
+	{"Castor", "", "Alpha Geminorum,α Gem", ObjectTypeStar, "Gem", 1.58, "07 34 35.87 +31 53 17.8"},
+	{"Shaula", "", "Lambda Scorpii,λ Sco", ObjectTypeStar, "Sco", 1.62, "17 33 36.52 -37 06 13.8"},
+	{"Gacrux", "", "Gamma Crucis,γ Cru", ObjectTypeStar, "Cru", 1.63, "12 31 09.96 -57 06 47.6"},
+	{"Bellatrix", "", "Gamma Orionis,γ Ori", ObjectTypeStar, "Ori", 1.64, "05 25 07.86 +06 20 58.9"},
+	{"Elnath", "", "Beta Tauri,β Tau", ObjectTypeStar, "Tau", 1.65, "05 26 17.51 +28 36 26.8"},
+	{"Miaplacidus", "", "Beta Carinae,β Car", ObjectTypeStar, "Car", 1.68, "09 13 12.00 -69 43 01.9"},
+	{"Alnilam", "", "Epsilon Orionis,ε Ori", ObjectTypeStar, "Ori", 1.69, "05 36 12.81 -01 12 06.9"},
+	{"Alnair", "", "Alpha Gruis,α Gru", ObjectTypeStar, "Gru", 1.74, "22 08 13.98 -46 57 39.5"},
+	{"Alnitak", "", "Zeta Orionis,ζ Ori", ObjectTypeStar, "Ori", 1.77, "05 40 45.53 -01 56 33.3"},
+	{"Alioth", "", "Epsilon Ursae Majoris,ε UMa", ObjectTypeStar, "UMa", 1.77, "12 54 01.75 +55 57 35.4"},
+	{"Dubhe", "", "Alpha Ursae Majoris,α UMa", ObjectTypeStar, "UMa", 1.79, "11 03 43.67 +61 45 03.7"},
+	{"Mirfak", "", "Alpha Persei,α Per", ObjectTypeStar, "Per", 1.79, "03 24 19.37 +49 51 40.2"},
+	{"Wezen", "", "Delta Canis Majoris,δ CMa", ObjectTypeStar, "CMa", 1.84, "07 08 23.48 -26 23 35.5"},
+	{"Kaus Australis", "", "Epsilon Sagittarii,ε Sgr", ObjectTypeStar, "Sgr", 1.85, "18 24 10.32 -34 23 04.6"},
+	{"Avior", "", "Epsilon Carinae,ε Car", ObjectTypeStar, "Car", 1.86, "08 22 30.84 -59 30 34.1"},
+	{"Alkaid", "", "Eta Ursae Majoris,η UMa,Benetnasch", ObjectTypeStar, "UMa", 1.86, "13 47 32.44 +49 18 47.8"},
+	{"Sargas", "", "Theta Scorpii,θ Sco", ObjectTypeStar, "Sco", 1.86, "17 37 19.13 -42 59 52.2"},
+	{"Menkalinan", "", "Beta Aurigae,β Aur", ObjectTypeStar, "Aur", 1.90, "05 59 31.72 +44 56 50.8"},
+	{"Atria", "", "Alpha Trianguli Australis,α TrA", ObjectTypeStar, "TrA", 1.91, "16 48 39.90 -69 01 39.8"},
+	{"Alhena", "", "Gamma Geminorum,γ Gem", ObjectTypeStar, "Gem", 1.92, "06 37 42.71 +16 23 57.4"},
+	{"Peacock", "", "Alpha Pavonis,α Pav", ObjectTypeStar, "Pav", 1.94, "20 25 38.86 -56 44 06.3"},
+	{"Polaris", "", "Alpha Ursae Minoris,α UMi,North Star,Pole Star", ObjectTypeStar, "UMi", 1.98, "02 31 49.09 +89 15 50.8"},
+	{"Mirzam", "", "Beta Canis Majoris,β CMa", ObjectTypeStar, "CMa", 1.98, "06 22 41.99 -17 57 21.3"},
+	{"Alphard", "", "Alpha Hydrae,α Hya", ObjectTypeStar, "Hya", 1.98, "09 27 35.24 -08 39 31.0"},
+	{"Hamal", "", "Alpha Arietis,α Ari", ObjectTypeStar, "Ari", 2.00, "02 07 10.41 +23 27 44.7"},
+	{"Algieba", "", "Gamma Leonis,γ Leo", ObjectTypeStar, "Leo", 2.01, "10 19 58.35 +19 50 29.4"},
+	{"Diphda", "", "Beta Ceti,β Cet,Deneb Kaitos", ObjectTypeStar, "Cet", 2.04, "00 43 35.37 -17 59 11.8"},
+	{"Nunki", "", "Sigma Sagittarii,σ Sgr", ObjectTypeStar, "Sgr", 2.05, "18 55 15.93 -26 17 48.2"},
+	{"Mirach", "", "Beta Andromedae,β And", ObjectTypeStar, "And", 2.05, "01 09 43.92 +35 37 14.0"},
+	{"Menkent", "", "Theta Centauri,θ Cen", ObjectTypeStar, "Cen", 2.06, "14 06 40.95 -36 22 11.8"},
+	{"Alpheratz", "", "Alpha Andromedae,α And", ObjectTypeStar, "And", 2.06, "00 08 23.26 +29 05 25.6"},
+	{"Rasalhague", "", "Alpha Ophiuchi,α Oph", ObjectTypeStar, "Oph", 2.08, "17 34 56.07 +12 33 36.1"},
+	{"Kochab", "", "Beta Ursae Minoris,β UMi", ObjectTypeStar, "UMi", 2.08, "14 50 42.33 +74 09 19.8"},
+	{"Saiph", "", "Kappa Orionis,κ Ori", ObjectTypeStar, "Ori", 2.09, "05 47 45.39 -09 40 10.6"},
+	{"Almach", "", "Gamma Andromedae,γ And", ObjectTypeStar, "And", 2.10, "02 03 53.95 +42 19 47.0"},
+	{"Algol", "", "Beta Persei,β Per,Demon Star", ObjectTypeStar, "Per", 2.12, "03 08 10.13 +40 57 20.3"},
+	{"Denebola", "", "Beta Leonis,β Leo", ObjectTypeStar, "Leo", 2.14, "11 49 03.58 +14 34 19.4"},
+	{"Mintaka", "", "Delta Orionis,δ Ori", ObjectTypeStar, "Ori", 2.23, "05 32 00.40 -00 17 56.7"},
+	{"Sadr", "", "Gamma Cygni,γ Cyg", ObjectTypeStar, "Cyg", 2.23, "20 22 13.70 +40 15 24.0"},
+	{"Eltanin", "", "Gamma Draconis,γ Dra", ObjectTypeStar, "Dra", 2.23, "17 56 36.37 +51 29 20.0"},
+	{"Mizar", "", "Zeta Ursae Majoris,ζ UMa", ObjectTypeStar, "UMa", 2.23, "13 23 55.54 +54 55 31.3"},
+	{"Alphecca", "", "Alpha Coronae Borealis,α CrB,Gemma", ObjectTypeStar, "CrB", 2.23, "15 34 41.27 +26 42 52.9"},
+	{"Schedar", "", "Alpha Cassiopeiae,α Cas", ObjectTypeStar, "Cas", 2.24, "00 40 30.44 +56 32 14.4"},
+	{"Suhail", "", "Lambda Velorum,λ Vel", ObjectTypeStar, "Vel", 2.21, "09 07 59.76 -43 25 57.3"},
+	{"Caph", "", "Beta Cassiopeiae,β Cas", ObjectTypeStar, "Cas", 2.28, "00 09 10.69 +59 08 59.2"},
+	{"Dschubba", "", "Delta Scorpii,δ Sco", ObjectTypeStar, "Sco", 2.29, "16 00 20.01 -22 37 18.1"},
+	{"Merak", "", "Beta Ursae Majoris,β UMa", ObjectTypeStar, "UMa", 2.37, "11 01 50.48 +56 22 56.7"},
+	{"Izar", "", "Epsilon Bootis,ε Boo", ObjectTypeStar, "Boo", 2.37, "14 44 59.22 +27 04 27.2"},
+	{"Enif", "", "Epsilon Pegasi,ε Peg", ObjectTypeStar, "Peg", 2.39, "21 44 11.16 +09 52 30.0"},
+	{"Ankaa", "", "Alpha Phoenicis,α Phe", ObjectTypeStar, "Phe", 2.40, "00 26 17.05 -42 18 21.5"},
+	{"Scheat", "", "Beta Pegasi,β Peg", ObjectTypeStar, "Peg", 2.42, "23 03 46.46 +28 04 58.0"},
+	{"Sabik", "", "Eta Ophiuchi,η Oph", ObjectTypeStar, "Oph", 2.43, "17 10 22.69 -15 43 29.7"},
+	{"Phecda", "", "Gamma Ursae Majoris,γ UMa", ObjectTypeStar, "UMa", 2.44, "11 53 49.85 +53 41 41.1"},
+	{"Alderamin", "", "Alpha Cephei,α Cep", ObjectTypeStar, "Cep", 2.45, "21 18 34.77 +62 35 08.1"},
+	{"Markab", "", "Alpha Pegasi,α Peg", ObjectTypeStar, "Peg", 2.49, "23 04 45.65 +15 12 19.3"},
+	{"Menkar", "", "Alpha Ceti,α Cet", ObjectTypeStar, "Cet", 2.54, "03 02 16.77 +04 05 23.1"},
+	{"Gienah", "", "Gamma Corvi,γ Crv", ObjectTypeStar, "Crv", 2.59, "12 15 48.37 -17 32 31.0"},
+	{"Zubeneschamali", "", "Beta Librae,β Lib", ObjectTypeStar, "Lib", 2.61, "15 17 00.41 -09 22 58.5"},
+	{"Unukalhai", "", "Alpha Serpentis,α Ser", ObjectTypeStar, "Ser", 2.63, "15 44 16.07 +06 25 32.3"},
+	{"Zubenelgenubi", "", "Alpha Librae,α Lib", ObjectTypeStar, "Lib", 2.75, "14 50 52.71 -16 02 30.4"},
+	{"Rasalgethi", "", "Alpha Herculis,α Her", ObjectTypeStar, "Her", 2.78, "17 14 38.86 +14 23 25.2"},
+	{"Alcyone", "", "Eta Tauri,η Tau", ObjectTypeStar, "Tau", 2.87, "03 47 29.08 +24 06 18.5"},
+	{"Acamar", "", "Theta Eridani,θ Eri", ObjectTypeStar, "Eri", 2.88, "02 58 15.68 -40 18 17.0"},
+	{"Mira", "", "Omicron Ceti,ο Cet", ObjectTypeStar, "Cet", 3.04, "02 19 20.79 -02 58 39.5"},
+	{"Albireo", "", "Beta Cygni,β Cyg", ObjectTypeStar, "Cyg", 3.08, "19 30 43.28 +27 57 34.8"},
+	{"Megrez", "", "Delta Ursae Majoris,δ UMa", ObjectTypeStar, "UMa", 3.31, "12 15 25.56 +57 01 57.4"},
+	{"Thuban", "", "Alpha Draconis,α Dra", ObjectTypeStar, "Dra", 3.65, "14 04 23.35 +64 22 33.1"},
+}
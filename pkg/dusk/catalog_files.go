@@ -0,0 +1,521 @@
+package dusk
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+	the epoch of the Hipparcos catalogue astrometry, i.e., J1991.25 (in Julian date)
+*/
+var HIPPARCOS_EPOCH float64 = 2448349.0625
+
+type CatalogRecord struct {
+	/*
+		Catalog - the source catalogue of the record, e.g., "BSC5", "HIP" or "OpenNGC"
+	*/
+	Catalog string `json:"catalog"`
+	/*
+		Identifier - the designation of the record within its catalogue, e.g., "HR 7001", "HIP 91262" or "NGC 224"
+	*/
+	Identifier string `json:"identifier"`
+	/*
+		Name - the Bayer / Flamsteed designation or common name(s) of the object (if any)
+	*/
+	Name string `json:"name"`
+	/*
+		Type - the classification of the object, e.g., star, galaxy or open cluster
+	*/
+	Type ObjectType `json:"type"`
+	/*
+		Constellation - the IAU abbreviation of the constellation (if given by the catalogue)
+	*/
+	Constellation string `json:"constellation"`
+	/*
+		Magnitude - the apparent visual magnitude (nil if not given by the catalogue)
+	*/
+	Magnitude *float64 `json:"magnitude"`
+	/*
+		Epoch - the epoch of the position (in Julian date), e.g., J2000 or J1991.25 for Hipparcos
+	*/
+	Epoch float64 `json:"epoch"`
+	/*
+		EquatorialCoordinate - the position at the epoch, referred to the equinox of J2000 (in degrees)
+	*/
+	EquatorialCoordinate EquatorialCoordinate `json:"eq"`
+	/*
+		ProperMotionRA - the proper motion in right ascension, μα* = μα cos δ (in milliarcseconds per year)
+	*/
+	ProperMotionRA float64 `json:"pmRA"`
+	/*
+		ProperMotionDec - the proper motion in declination, μδ (in milliarcseconds per year)
+	*/
+	ProperMotionDec float64 `json:"pmDec"`
+	/*
+		Parallax - the trigonometric parallax (in milliarcseconds)
+	*/
+	Parallax float64 `json:"parallax"`
+	/*
+		RadialVelocity - the heliocentric radial velocity (in km/s)
+	*/
+	RadialVelocity float64 `json:"radialVelocity"`
+}
+
+/*
+	GetCatalogRecordEquatorialPosition()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param rec - the catalogue record
+	@returns the mean equatorial coordinate { ra, dec } of the record, with proper motion applied from the record's epoch and precessed to the equinox of date (in degrees)
+*/
+func GetCatalogRecordEquatorialPosition(datetime time.Time, rec CatalogRecord) EquatorialCoordinate {
+	var JD = GetJulianDate(datetime)
+
+	var eq = GetProperMotionCorrectedEquatorialCoordinate(rec.EquatorialCoordinate, rec.ProperMotionRA, rec.ProperMotionDec, (JD-rec.Epoch)/365.25)
+
+	return GetPrecessedEquatorialCoordinate(eq, J2000, JD)
+}
+
+/*
+	LoadYaleBrightStarCatalog()
+
+	@param path - the path to the Yale Bright Star Catalogue, 5th Revised Ed. (V/50) "catalog" file on local disk
+	@returns the records of the catalogue, or an error.
+*/
+func LoadYaleBrightStarCatalog(path string) ([]CatalogRecord, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return ReadYaleBrightStarCatalog(f)
+}
+
+/*
+	ReadYaleBrightStarCatalog()
+
+	N.B. entries without a J2000 position (the novae and extragalactic objects removed from the catalogue) are skipped.
+
+	@param r - the reader of the fixed-width Yale Bright Star Catalogue, 5th Revised Ed. (V/50) "catalog" file
+	@returns the records of the catalogue, or an error.
+	@see https://cdsarc.cds.unistra.fr/viz-bin/ReadMe/V/50
+*/
+func ReadYaleBrightStarCatalog(r io.Reader) ([]CatalogRecord, error) {
+	var records = []CatalogRecord{}
+
+	var scanner = bufio.NewScanner(r)
+
+	var line = 0
+
+	for scanner.Scan() {
+		line++
+
+		var row = scanner.Text()
+
+		if strings.TrimSpace(row) == "" {
+			continue
+		}
+
+		// pad short rows, as trailing blank columns are often stripped:
+		if len(row) < 197 {
+			row += strings.Repeat(" ", 197-len(row))
+		}
+
+		// the J2000 right ascension is blank for the entries removed from the catalogue:
+		if strings.TrimSpace(row[75:83]) == "" {
+			continue
+		}
+
+		var errs = &fixedWidthErrors{}
+
+		var HR = errs.int(row, 0, 4)
+
+		var α = errs.float(row, 75, 77)*15 + errs.float(row, 77, 79)/4 + errs.float(row, 79, 83)/240
+
+		var δ = errs.float(row, 84, 86) + errs.float(row, 86, 88)/60 + errs.float(row, 88, 90)/3600
+
+		if row[83] == '-' {
+			δ = -δ
+		}
+
+		var pmRA = errs.optionalFloat(row, 148, 154) * 1000
+
+		var pmDec = errs.optionalFloat(row, 154, 160) * 1000
+
+		var parallax = errs.optionalFloat(row, 161, 166) * 1000
+
+		var radialVelocity = errs.optionalFloat(row, 166, 170)
+
+		if errs.err != nil {
+			return nil, fmt.Errorf("invalid Yale Bright Star Catalogue entry on line %d: %w", line, errs.err)
+		}
+
+		records = append(records, CatalogRecord{
+			Catalog:              "BSC5",
+			Identifier:           fmt.Sprintf("HR %d", HR),
+			Name:                 strings.Join(strings.Fields(row[4:14]), " "),
+			Type:                 ObjectTypeStar,
+			Magnitude:            parseOptionalFloat(row[102:107]),
+			Epoch:                J2000,
+			EquatorialCoordinate: EquatorialCoordinate{RightAscension: α, Declination: δ},
+			ProperMotionRA:       pmRA,
+			ProperMotionDec:      pmDec,
+			Parallax:             parallax,
+			RadialVelocity:       radialVelocity,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+/*
+	LoadHipparcosCatalog()
+
+	@param path - the path to the Hipparcos main catalogue (I/239) "hip_main.dat" file on local disk
+	@returns the records of the catalogue, or an error.
+*/
+func LoadHipparcosCatalog(path string) ([]CatalogRecord, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return ReadHipparcosCatalog(f)
+}
+
+/*
+	ReadHipparcosCatalog()
+
+	N.B. positions are given at the catalogue epoch J1991.25, referred to the ICRS (equivalent to the equinox of J2000).
+
+	@param r - the reader of the pipe-delimited Hipparcos main catalogue (I/239) "hip_main.dat" file
+	@returns the records of the catalogue, or an error.
+	@see https://cdsarc.cds.unistra.fr/viz-bin/ReadMe/I/239
+*/
+func ReadHipparcosCatalog(r io.Reader) ([]CatalogRecord, error) {
+	var records = []CatalogRecord{}
+
+	var scanner = bufio.NewScanner(r)
+
+	var line = 0
+
+	for scanner.Scan() {
+		line++
+
+		var row = scanner.Text()
+
+		if strings.TrimSpace(row) == "" {
+			continue
+		}
+
+		var fields = strings.Split(row, "|")
+
+		if len(fields) < 14 {
+			return nil, fmt.Errorf("invalid Hipparcos catalogue entry on line %d: expected at least 14 fields, got %d", line, len(fields))
+		}
+
+		HIP, err := strconv.Atoi(strings.TrimSpace(fields[1]))
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid Hipparcos catalogue entry on line %d: %w", line, err)
+		}
+
+		var eq EquatorialCoordinate
+
+		// prefer the full precision degrees; fall back to the sexagesimal position for entries without an astrometric solution:
+		if strings.TrimSpace(fields[8]) != "" && strings.TrimSpace(fields[9]) != "" {
+			α, err := strconv.ParseFloat(strings.TrimSpace(fields[8]), 64)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid Hipparcos catalogue entry on line %d: %w", line, err)
+			}
+
+			δ, err := strconv.ParseFloat(strings.TrimSpace(fields[9]), 64)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid Hipparcos catalogue entry on line %d: %w", line, err)
+			}
+
+			eq = EquatorialCoordinate{RightAscension: α, Declination: δ}
+		} else {
+			eq, err = ParseEquatorialCoordinate(fields[3] + " " + fields[4])
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid Hipparcos catalogue entry on line %d: %w", line, err)
+			}
+		}
+
+		records = append(records, CatalogRecord{
+			Catalog:              "HIP",
+			Identifier:           fmt.Sprintf("HIP %d", HIP),
+			Type:                 ObjectTypeStar,
+			Magnitude:            parseOptionalFloat(fields[5]),
+			Epoch:                HIPPARCOS_EPOCH,
+			EquatorialCoordinate: eq,
+			ProperMotionRA:       valueOrZero(parseOptionalFloat(fields[12])),
+			ProperMotionDec:      valueOrZero(parseOptionalFloat(fields[13])),
+			Parallax:             valueOrZero(parseOptionalFloat(fields[11])),
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+/*
+	LoadOpenNGCCatalog()
+
+	@param path - the path to the OpenNGC "NGC.csv" (or "addendum.csv") file on local disk
+	@returns the records of the catalogue, or an error.
+*/
+func LoadOpenNGCCatalog(path string) ([]CatalogRecord, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return ReadOpenNGCCatalog(f)
+}
+
+/*
+	ReadOpenNGCCatalog()
+
+	N.B. duplicated and non-existent entries are skipped, as are entries without a position.
+
+	@param r - the reader of the semicolon-delimited OpenNGC CSV file, including its header row
+	@returns the records of the catalogue, or an error.
+	@see https://github.com/mattiaverga/OpenNGC
+*/
+func ReadOpenNGCCatalog(r io.Reader) ([]CatalogRecord, error) {
+	var reader = csv.NewReader(r)
+
+	reader.Comma = ';'
+
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid OpenNGC catalogue header: %w", err)
+	}
+
+	var columns = make(map[string]int)
+
+	for i, h := range header {
+		columns[strings.TrimSpace(h)] = i
+	}
+
+	for _, required := range []string{"Name", "Type", "RA", "Dec"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("invalid OpenNGC catalogue header: missing column %q", required)
+		}
+	}
+
+	var column = func(row []string, name string) string {
+		i, ok := columns[name]
+
+		if !ok || i >= len(row) {
+			return ""
+		}
+
+		return strings.TrimSpace(row[i])
+	}
+
+	var records = []CatalogRecord{}
+
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid OpenNGC catalogue entry on line %d: %w", line, err)
+		}
+
+		var code = column(row, "Type")
+
+		if code == "Dup" || code == "NonEx" || column(row, "RA") == "" || column(row, "Dec") == "" {
+			continue
+		}
+
+		eq, err := ParseEquatorialCoordinate(column(row, "RA") + " " + column(row, "Dec"))
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid OpenNGC catalogue entry on line %d: %w", line, err)
+		}
+
+		var magnitude = parseOptionalFloat(column(row, "V-Mag"))
+
+		// fall back to the blue magnitude where the visual magnitude is not given:
+		if magnitude == nil {
+			magnitude = parseOptionalFloat(column(row, "B-Mag"))
+		}
+
+		var names = []string{}
+
+		// cross-reference the Messier number (if any), e.g., "M 31":
+		if m := strings.TrimLeft(column(row, "M"), "0"); m != "" {
+			names = append(names, "M "+m)
+		}
+
+		if common := column(row, "Common names"); common != "" {
+			names = append(names, common)
+		}
+
+		records = append(records, CatalogRecord{
+			Catalog:              "OpenNGC",
+			Identifier:           formatOpenNGCIdentifier(column(row, "Name")),
+			Name:                 strings.Join(names, ","),
+			Type:                 getOpenNGCObjectType(code),
+			Constellation:        column(row, "Const"),
+			Magnitude:            magnitude,
+			Epoch:                J2000,
+			EquatorialCoordinate: eq,
+			ProperMotionRA:       valueOrZero(parseOptionalFloat(column(row, "Pm-RA"))),
+			ProperMotionDec:      valueOrZero(parseOptionalFloat(column(row, "Pm-Dec"))),
+			Parallax:             valueOrZero(parseOptionalFloat(column(row, "Pax"))),
+			RadialVelocity:       valueOrZero(parseOptionalFloat(column(row, "RadVel"))),
+		})
+	}
+
+	return records, nil
+}
+
+/*
+	formatOpenNGCIdentifier()
+
+	@param name - the OpenNGC name, e.g., "NGC0224" or "IC0342"
+	@returns the conventional identifier, e.g., "NGC 224" or "IC 342"
+*/
+func formatOpenNGCIdentifier(name string) string {
+	for _, prefix := range []string{"NGC", "IC"} {
+		if strings.HasPrefix(name, prefix) {
+			var number = strings.TrimLeft(strings.TrimPrefix(name, prefix), "0")
+
+			if number != "" {
+				return prefix + " " + number
+			}
+		}
+	}
+
+	return name
+}
+
+/*
+	getOpenNGCObjectType()
+
+	@param code - the OpenNGC object type code, e.g., "G", "OCl" or "PN"
+	@returns the corresponding ObjectType
+*/
+func getOpenNGCObjectType(code string) ObjectType {
+	switch code {
+	case "*":
+		return ObjectTypeStar
+	case "**":
+		return ObjectTypeDoubleStar
+	case "*Ass":
+		return ObjectTypeAsterism
+	case "OCl", "Cl+N":
+		return ObjectTypeOpenCluster
+	case "GCl":
+		return ObjectTypeGlobularCluster
+	case "G", "GPair", "GTrpl", "GGroup":
+		return ObjectTypeGalaxy
+	case "PN":
+		return ObjectTypePlanetaryNebula
+	case "DrkN":
+		return ObjectTypeDarkNebula
+	case "SNR":
+		return ObjectTypeSupernovaRemnant
+	case "HII", "EmN", "Neb", "RfN":
+		return ObjectTypeNebula
+	}
+
+	return ObjectType(code)
+}
+
+/*
+	fixedWidthErrors accumulates the first error encountered whilst parsing the columns of a fixed-width row.
+*/
+type fixedWidthErrors struct {
+	err error
+}
+
+func (e *fixedWidthErrors) int(row string, from int, to int) int {
+	v, err := strconv.Atoi(strings.TrimSpace(row[from:to]))
+
+	if err != nil && e.err == nil {
+		e.err = fmt.Errorf("bytes %d-%d: %w", from+1, to, err)
+	}
+
+	return v
+}
+
+func (e *fixedWidthErrors) float(row string, from int, to int) float64 {
+	v, err := strconv.ParseFloat(strings.TrimSpace(row[from:to]), 64)
+
+	if err != nil && e.err == nil {
+		e.err = fmt.Errorf("bytes %d-%d: %w", from+1, to, err)
+	}
+
+	return v
+}
+
+func (e *fixedWidthErrors) optionalFloat(row string, from int, to int) float64 {
+	var s = strings.TrimSpace(row[from:to])
+
+	if s == "" {
+		return 0
+	}
+
+	return e.float(row, from, to)
+}
+
+/*
+	parseOptionalFloat()
+
+	@param s - the (possibly blank) decimal string
+	@returns a pointer to the parsed value, or nil if the string is blank or malformed.
+*/
+func parseOptionalFloat(s string) *float64 {
+	v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+
+	if err != nil || math.IsNaN(v) {
+		return nil
+	}
+
+	return &v
+}
+
+func valueOrZero(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+
+	return *v
+}
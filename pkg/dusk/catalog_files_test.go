@@ -0,0 +1,180 @@
+package dusk
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+var yaleBrightStarCatalogSample = strings.Join([]string{
+	"  92",
+	"2491  9Alp CMa                                                             064508.9-164258            -1.46                                         -0.553-1.205 +.375  -8",
+	"7001  3Alp Lyr           172167                                            183656.3+384701             0.03                                         +0.201+0.286 +.123 -14",
+}, "\n")
+
+var hipparcosCatalogSample = strings.Join([]string{
+	"H|       91262| |18 36 56.19|+38 46 58.8| 0.03| |H|279.23410832|+38.78299311| |  128.93|  201.02|  286.46|  0.55|",
+	"H|        1000| |00 12 32.06|-42 21 07.3|10.13| |H|          |            | |        |        |        |      |",
+}, "\n")
+
+var openNGCCatalogSample = strings.Join([]string{
+	"Name;Type;RA;Dec;Const;MajAx;MinAx;PosAng;B-Mag;V-Mag;J-Mag;H-Mag;K-Mag;SurfBr;Hubble;Pax;Pm-RA;Pm-Dec;RadVel;Redshift;Cstar U-Mag;Cstar B-Mag;Cstar V-Mag;M;NGC;IC;Cstar Names;Identifiers;Common names;NED notes;OpenNGC notes;Sources",
+	"NGC0224;G;00:42:44.35;+41:16:08.6;And;177.83;69.66;35;4.29;3.44;2.09;1.28;0.98;23.63;Sb;;;;-300;-0.001;;;;031;;;;2MASX J00424433+4116074;Andromeda Galaxy;;;",
+	"NGC0225;OCl;00:43:39.0;+61:46:30;Cas;;;;7.00;;;;;;;;;;;;;;;;;;;;;;;",
+	"NGC0226;Dup;00:42:54.0;+32:34:48;And;;;;;;;;;;;;;;;;;;;;;;;;;;;",
+	"NGC7000;HII;20:59:17.1;+44:31:44;Cyg;120.0;30.0;;4.00;;;;;;;;;;;;;;;;;;;;North America Nebula;;;",
+}, "\n")
+
+func TestReadYaleBrightStarCatalog(t *testing.T) {
+	records, err := ReadYaleBrightStarCatalog(strings.NewReader(yaleBrightStarCatalogSample))
+
+	if err != nil {
+		t.Errorf("got %q", err)
+		return
+	}
+
+	if len(records) != 2 {
+		t.Errorf("got %d, wanted %d", len(records), 2)
+		return
+	}
+
+	var vega = records[1]
+
+	if vega.Identifier != "HR 7001" || vega.Name != "3Alp Lyr" {
+		t.Errorf("got %q %q, wanted %q %q", vega.Identifier, vega.Name, "HR 7001", "3Alp Lyr")
+	}
+
+	if math.Abs(vega.EquatorialCoordinate.RightAscension-279.234583) > 0.000001 {
+		t.Errorf("got %f, wanted %f", vega.EquatorialCoordinate.RightAscension, 279.234583)
+	}
+
+	if math.Abs(vega.EquatorialCoordinate.Declination-38.783611) > 0.000001 {
+		t.Errorf("got %f, wanted %f", vega.EquatorialCoordinate.Declination, 38.783611)
+	}
+
+	if vega.Magnitude == nil || *vega.Magnitude != 0.03 {
+		t.Errorf("got %v, wanted %f", vega.Magnitude, 0.03)
+	}
+
+	if vega.ProperMotionRA != 201 || vega.ProperMotionDec != 286 || vega.Parallax != 123 || vega.RadialVelocity != -14 {
+		t.Errorf("got %f %f %f %f", vega.ProperMotionRA, vega.ProperMotionDec, vega.Parallax, vega.RadialVelocity)
+	}
+
+	if records[0].EquatorialCoordinate.Declination > -16.7 {
+		t.Errorf("got %f, wanted a southern declination", records[0].EquatorialCoordinate.Declination)
+	}
+}
+
+func TestReadYaleBrightStarCatalogInvalid(t *testing.T) {
+	_, err := ReadYaleBrightStarCatalog(strings.NewReader("7001  3Alp Lyr" + strings.Repeat(" ", 61) + "18x656.3+384701"))
+
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestReadHipparcosCatalog(t *testing.T) {
+	records, err := ReadHipparcosCatalog(strings.NewReader(hipparcosCatalogSample))
+
+	if err != nil {
+		t.Errorf("got %q", err)
+		return
+	}
+
+	if len(records) != 2 {
+		t.Errorf("got %d, wanted %d", len(records), 2)
+		return
+	}
+
+	var vega = records[0]
+
+	if vega.Identifier != "HIP 91262" || vega.Epoch != HIPPARCOS_EPOCH {
+		t.Errorf("got %q %f", vega.Identifier, vega.Epoch)
+	}
+
+	if vega.ProperMotionRA != 201.02 || vega.ProperMotionDec != 286.46 || vega.Parallax != 128.93 {
+		t.Errorf("got %f %f %f", vega.ProperMotionRA, vega.ProperMotionDec, vega.Parallax)
+	}
+
+	// an entry without an astrometric solution falls back to the sexagesimal position:
+	if math.Abs(records[1].EquatorialCoordinate.Declination+42.352028) > 0.00001 {
+		t.Errorf("got %f, wanted %f", records[1].EquatorialCoordinate.Declination, -42.352028)
+	}
+}
+
+func TestReadOpenNGCCatalog(t *testing.T) {
+	records, err := ReadOpenNGCCatalog(strings.NewReader(openNGCCatalogSample))
+
+	if err != nil {
+		t.Errorf("got %q", err)
+		return
+	}
+
+	// the duplicate entry is skipped:
+	if len(records) != 3 {
+		t.Errorf("got %d, wanted %d", len(records), 3)
+		return
+	}
+
+	var andromeda = records[0]
+
+	if andromeda.Identifier != "NGC 224" || andromeda.Name != "M 31,Andromeda Galaxy" || andromeda.Type != ObjectTypeGalaxy {
+		t.Errorf("got %q %q %q", andromeda.Identifier, andromeda.Name, andromeda.Type)
+	}
+
+	if andromeda.Magnitude == nil || *andromeda.Magnitude != 3.44 {
+		t.Errorf("got %v, wanted %f", andromeda.Magnitude, 3.44)
+	}
+
+	if math.Abs(andromeda.EquatorialCoordinate.RightAscension-10.684792) > 0.000001 {
+		t.Errorf("got %f, wanted %f", andromeda.EquatorialCoordinate.RightAscension, 10.684792)
+	}
+
+	// the blue magnitude is used where the visual magnitude is not given:
+	if records[1].Magnitude == nil || *records[1].Magnitude != 7 || records[1].Type != ObjectTypeOpenCluster {
+		t.Errorf("got %v %q", records[1].Magnitude, records[1].Type)
+	}
+
+	if records[2].Type != ObjectTypeNebula || records[2].Name != "North America Nebula" {
+		t.Errorf("got %q %q", records[2].Type, records[2].Name)
+	}
+}
+
+func TestReadOpenNGCCatalogMissingColumns(t *testing.T) {
+	_, err := ReadOpenNGCCatalog(strings.NewReader("Name;Type;Const\nNGC0224;G;And"))
+
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestGetCatalogRecordEquatorialPositionHipparcosAtJ2000(t *testing.T) {
+	records, err := ReadHipparcosCatalog(strings.NewReader(hipparcosCatalogSample))
+
+	if err != nil {
+		t.Errorf("got %q", err)
+		return
+	}
+
+	var got = GetCatalogRecordEquatorialPosition(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC), records[0])
+
+	// the J2000 position of Vega, i.e., proper motion applied over 8.75 years:
+	var want = EquatorialCoordinate{RightAscension: 279.234735, Declination: 38.783689}
+
+	if math.Abs(got.RightAscension-want.RightAscension) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got.RightAscension, want.RightAscension)
+	}
+
+	if math.Abs(got.Declination-want.Declination) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got.Declination, want.Declination)
+	}
+}
+
+func TestLoadYaleBrightStarCatalogMissingFile(t *testing.T) {
+	_, err := LoadYaleBrightStarCatalog("does-not-exist.dat")
+
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+}
@@ -0,0 +1,173 @@
+package dusk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGetCatalogObjectsCount(t *testing.T) {
+	var messier, caldwell, stars, planets int = 0, 0, 0, 0
+
+	for _, obj := range GetCatalogObjects() {
+		switch {
+		case obj.Type == ObjectTypeStar:
+			stars++
+		case obj.Type == ObjectTypePlanet:
+			planets++
+		case obj.Type == ObjectTypeSun || obj.Type == ObjectTypeMoon:
+			continue
+		case obj.Designation[0] == 'M':
+			messier++
+		case obj.Designation[0] == 'C':
+			caldwell++
+		}
+	}
+
+	if messier != 110 {
+		t.Errorf("got %d, wanted %d", messier, 110)
+	}
+
+	if caldwell != 109 {
+		t.Errorf("got %d, wanted %d", caldwell, 109)
+	}
+
+	if planets != 7 {
+		t.Errorf("got %d, wanted %d", planets, 7)
+	}
+
+	if stars == 0 {
+		t.Errorf("got %d, wanted more than zero", stars)
+	}
+}
+
+func TestGetCatalogObjectsDesignationsAreUnique(t *testing.T) {
+	var seen = make(map[string]bool)
+
+	for _, obj := range GetCatalogObjects() {
+		var key = normaliseCatalogName(obj.Designation)
+
+		if seen[key] {
+			t.Errorf("duplicate designation %q", obj.Designation)
+		}
+
+		seen[key] = true
+	}
+}
+
+func TestResolveCatalogObjectOrionNebula(t *testing.T) {
+	var names = []string{"M42", "m 42", "Messier 42", "M042", "NGC 1976", "ngc1976", "Orion Nebula", "orion-nebula"}
+
+	for _, name := range names {
+		obj, err := ResolveCatalogObject(name)
+
+		if err != nil {
+			t.Errorf("got %q", err)
+			continue
+		}
+
+		if obj.Designation != "M42" {
+			t.Errorf("%s: got %q, wanted %q", name, obj.Designation, "M42")
+		}
+	}
+}
+
+func TestResolveCatalogObjectVega(t *testing.T) {
+	obj, err := ResolveCatalogObject("vega")
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if obj.Constellation != "Lyr" {
+		t.Errorf("got %q, wanted %q", obj.Constellation, "Lyr")
+	}
+
+	if math.Abs(obj.EquatorialCoordinate.RightAscension-279.234750) > 0.00001 {
+		t.Errorf("got %f, wanted %f", obj.EquatorialCoordinate.RightAscension, 279.234750)
+	}
+
+	if math.Abs(obj.EquatorialCoordinate.Declination-38.783694) > 0.00001 {
+		t.Errorf("got %f, wanted %f", obj.EquatorialCoordinate.Declination, 38.783694)
+	}
+}
+
+func TestResolveCatalogObjectCaldwell(t *testing.T) {
+	obj, err := ResolveCatalogObject("Caldwell 14")
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if obj.Name != "Double Cluster" {
+		t.Errorf("got %q, wanted %q", obj.Name, "Double Cluster")
+	}
+}
+
+func TestResolveCatalogObjectUnknown(t *testing.T) {
+	_, err := ResolveCatalogObject("Vulcan")
+
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestGetNamedObjectEquatorialPositionPrecessed(t *testing.T) {
+	var datetime time.Time = time.Date(2028, 11, 13, 4, 33, 36, 0, time.UTC)
+
+	got, err := GetNamedObjectEquatorialPosition(datetime, "Polaris")
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	// Polaris precesses rapidly in right ascension, close to the celestial pole:
+	if math.Abs(got.RightAscension-37.954542) < 0.1 {
+		t.Errorf("got %f, expected the position to be precessed", got.RightAscension)
+	}
+
+	if math.Abs(got.Declination-89.264) > 0.2 {
+		t.Errorf("got %f, wanted %f", got.Declination, 89.264)
+	}
+}
+
+func TestGetNamedObjectEquatorialPositionJupiter(t *testing.T) {
+	got, err := GetNamedObjectEquatorialPosition(datetime, "Jupiter")
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	want := GetPlanetEquatorialPosition(datetime, Jupiter)
+
+	if got != want {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+}
+
+func TestGetNamedObjectEquatorialPositionSun(t *testing.T) {
+	got, err := GetNamedObjectEquatorialPosition(datetime, "Sun")
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	want := GetSolarEquatorialPosition(datetime)
+
+	if got != want {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+}
+
+func TestGetNamedObjectTransitBetelgeuse(t *testing.T) {
+	transit, err := GetNamedObjectTransit(datetime, "Betelgeuse", latitude, longitude)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+		return
+	}
+
+	if transit.Rise == nil || transit.Set == nil {
+		t.Errorf("expected Betelgeuse to rise and set")
+	}
+}
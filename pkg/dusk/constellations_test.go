@@ -0,0 +1,134 @@
+package dusk
+
+import (
+	"testing"
+)
+
+func TestConstellationBoundariesAreKnown(t *testing.T) {
+	var seen = make(map[string]bool)
+
+	for _, b := range constellationBoundaries {
+		if _, ok := GetConstellationByAbbreviation(b.abbreviation); !ok {
+			t.Errorf("unknown constellation %q", b.abbreviation)
+		}
+
+		seen[b.abbreviation] = true
+	}
+
+	if len(constellationBoundaries) != 357 {
+		t.Errorf("got %d, wanted %d", len(constellationBoundaries), 357)
+	}
+
+	if len(seen) != 88 {
+		t.Errorf("got %d, wanted %d", len(seen), 88)
+	}
+}
+
+func TestGetConstellationByAbbreviation(t *testing.T) {
+	var got, ok = GetConstellationByAbbreviation("CVn")
+
+	if !ok {
+		t.Errorf("got %v, wanted %v", ok, true)
+	}
+
+	if got.Name != "Canes Venatici" {
+		t.Errorf("got %q, wanted %q", got.Name, "Canes Venatici")
+	}
+
+	if _, ok = GetConstellationByAbbreviation("Xyz"); ok {
+		t.Errorf("got %v, wanted %v", ok, false)
+	}
+}
+
+func TestGetConstellationFromJ2000EquatorialCoordinate(t *testing.T) {
+	var tests = []struct {
+		eq   EquatorialCoordinate
+		want string
+	}{
+		// Vega:
+		{EquatorialCoordinate{RightAscension: 279.234735, Declination: 38.783689}, "Lyr"},
+		// Polaris:
+		{EquatorialCoordinate{RightAscension: 37.954561, Declination: 89.264109}, "UMi"},
+		// the south celestial pole:
+		{EquatorialCoordinate{RightAscension: 0, Declination: -90}, "Oct"},
+		// the Orion Nebula:
+		{EquatorialCoordinate{RightAscension: 83.8221, Declination: -5.3911}, "Ori"},
+		// the Sombrero Galaxy, which lies within a degree of the border with Corvus:
+		{EquatorialCoordinate{RightAscension: 189.9976, Declination: -11.6231}, "Vir"},
+		// Alpheratz, which is on the Andromeda side of the border with Pegasus:
+		{EquatorialCoordinate{RightAscension: 2.096916, Declination: 29.090431}, "And"},
+	}
+
+	for _, tt := range tests {
+		var got = GetConstellationFromJ2000EquatorialCoordinate(tt.eq)
+
+		if got.Abbreviation != tt.want {
+			t.Errorf("got %q, wanted %q", got.Abbreviation, tt.want)
+		}
+	}
+}
+
+func TestGetConstellationForEquinoxB1950(t *testing.T) {
+	// positions referred to the equinox of B1950.0, well away from any boundary:
+	var B1950 float64 = 2433282.4235
+
+	var got = GetConstellationForEquinox(EquatorialCoordinate{RightAscension: 9 * 15, Declination: 65}, B1950)
+
+	if got.Abbreviation != "UMa" {
+		t.Errorf("got %q, wanted %q", got.Abbreviation, "UMa")
+	}
+
+	got = GetConstellationForEquinox(EquatorialCoordinate{RightAscension: 23 * 15, Declination: -80}, B1950)
+
+	if got.Abbreviation != "Oct" {
+		t.Errorf("got %q, wanted %q", got.Abbreviation, "Oct")
+	}
+
+	got = GetConstellationForEquinox(EquatorialCoordinate{RightAscension: 18 * 15, Declination: 76}, B1950)
+
+	if got.Abbreviation != "Dra" {
+		t.Errorf("got %q, wanted %q", got.Abbreviation, "Dra")
+	}
+}
+
+func TestGetCatalogObjectsConstellationsAgreeWithBoundaries(t *testing.T) {
+	for _, obj := range GetCatalogObjects() {
+		if obj.Constellation == "" {
+			continue
+		}
+
+		var got = GetConstellationFromJ2000EquatorialCoordinate(obj.EquatorialCoordinate)
+
+		if got.Abbreviation != obj.Constellation {
+			t.Errorf("%s: got %q, wanted %q", obj.Designation, got.Abbreviation, obj.Constellation)
+		}
+	}
+}
+
+func TestGetLunarConstellation(t *testing.T) {
+	// the Moon on 12 April 1992 at α = 134.69°, δ = +13.77° (see ex.47.a of Meeus) lies in Cancer:
+	var got = GetLunarConstellation(d)
+
+	if got.Abbreviation != "Cnc" {
+		t.Errorf("got %q, wanted %q", got.Abbreviation, "Cnc")
+	}
+
+	if got.Name != "Cancer" {
+		t.Errorf("got %q, wanted %q", got.Name, "Cancer")
+	}
+}
+
+func TestGetCatalogObjectConstellationJupiter(t *testing.T) {
+	jupiter, err := ResolveCatalogObject("Jupiter")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Jupiter was in Aquarius throughout May 2021:
+	var got = GetCatalogObjectConstellation(datetime, jupiter)
+
+	if got.Abbreviation != "Aqr" {
+		t.Errorf("got %q, wanted %q", got.Abbreviation, "Aqr")
+	}
+}
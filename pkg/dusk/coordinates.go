@@ -1,6 +1,7 @@
 package dusk
 
 import (
+	"math"
 	"time"
 )
 
@@ -99,7 +100,7 @@ type TransitHorizontalCoordinate struct {
 	@see eq13.3 & eq13.4 p.93 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
 */
 func ConvertEclipticCoordinateToEquatorial(datetime time.Time, ec EclipticCoordinate) EquatorialCoordinate {
-	var J = GetCurrentJulianCenturyRelativeToJ2000(datetime)
+	var J = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
 
 	var L float64 = GetSolarMeanLongitude(J)
 
@@ -123,6 +124,58 @@ func ConvertEclipticCoordinateToEquatorial(datetime time.Time, ec EclipticCoordi
 	}
 }
 
+/*
+	ConvertEquatorialCoordinateToEcliptic()
+
+	N.B. this is the inverse of ConvertEclipticCoordinateToEquatorial(), i.e., using the true obliquity of the ecliptic.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param equatorial coordinate of type EquatorialCoordinate { ra, dec }
+	@returns the converted geocentric ecliptic coordinate { λ, β }
+	@see eq13.1 & eq13.2 p.93 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func ConvertEquatorialCoordinateToEcliptic(datetime time.Time, eq EquatorialCoordinate) EclipticCoordinate {
+	var J = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
+
+	var L float64 = GetSolarMeanLongitude(J)
+
+	var l float64 = GetLunarMeanLongitude(J)
+
+	var Ω float64 = GetLunarLongitudeOfTheAscendingNode(J)
+
+	var ε float64 = GetMeanObliquityOfTheEcliptic(J) + GetNutationInObliquityOfTheEcliptic(L, l, Ω)
+
+	return convertEquatorialCoordinateToEclipticForObliquity(eq, ε)
+}
+
+/*
+	convertEquatorialCoordinateToEclipticForObliquity()
+
+	@param equatorial coordinate of type EquatorialCoordinate { ra, dec }
+	@param ε - the obliquity of the ecliptic (in degrees), i.e., the mean obliquity for the mean ecliptic of date
+	@returns the converted geocentric ecliptic coordinate { λ, β }
+	@see eq13.1 & eq13.2 p.93 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func convertEquatorialCoordinateToEclipticForObliquity(eq EquatorialCoordinate, ε float64) EclipticCoordinate {
+	var α = eq.RightAscension
+
+	var δ = eq.Declination
+
+	var λ = atan2yx(sinx(α)*cosx(ε)+tanx(δ)*sinx(ε), cosx(α))
+
+	// correct for negative angles
+	if λ < 0 {
+		λ += 360
+	}
+
+	var β = asinx(sinx(δ)*cosx(ε) - cosx(δ)*sinx(ε)*sinx(α))
+
+	return EclipticCoordinate{
+		Longitude: λ,
+		Latitude:  β,
+	}
+}
+
 /*
 	ConvertEquatorialCoordinateToHorizontal()
 
@@ -130,10 +183,14 @@ func ConvertEclipticCoordinateToEquatorial(datetime time.Time, ec EclipticCoordi
 	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
 	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
 	@param equatorial coordinate of type EquatorialCoordiate { ra, dec }
-	@returns the equivalent horizontal coordinate for the given observers position
+	@returns the equivalent horizontal coordinate for the given observers position, corrected for polar motion where
+	Earth orientation parameters have been set by SetEarthOrientationTable()
 	@see eq13.5 and eq.6 p.93 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
 */
 func ConvertEquatorialCoordinateToHorizontal(datetime time.Time, longitude float64, latitude float64, eq EquatorialCoordinate) HorizontalCoordinate {
+	// refer the observer to the true pole of rotation (which is a no-op where no polar motion data is available):
+	longitude, latitude = GetPolarMotionCorrectedObserverCoordinate(datetime, longitude, latitude)
+
 	var LST float64 = GetLocalSiderealTime(datetime, longitude)
 
 	var ra float64 = GetHourAngle(eq.RightAscension, LST)
@@ -149,3 +206,39 @@ func ConvertEquatorialCoordinateToHorizontal(datetime time.Time, longitude float
 		Azimuth:  az,
 	}
 }
+
+/*
+	getApparentHourAngle()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param α - the apparent right ascension of the object, referred to the true equinox of date (in degrees)
+	@returns the local hour angle of the object between [-180°, 180°) (in degrees), from the apparent sidereal time
+*/
+func getApparentHourAngle(datetime time.Time, longitude float64, α float64) float64 {
+	return math.Mod(GetLocalSiderealTimeForModel(datetime, longitude, MeeusApparentSiderealTime)*15-α+540, 360) - 180
+}
+
+/*
+	getHorizontalCoordinateFromHourAngle()
+
+	@param H - the local hour angle of the object (in degrees)
+	@param δ - the declination of the object (in degrees)
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@returns the horizontal coordinate of the object, with the azimuth measured eastwards from north
+	@see eq.13.5 & eq.13.6 p.93 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func getHorizontalCoordinateFromHourAngle(H float64, δ float64, latitude float64) HorizontalCoordinate {
+	// the azimuth of eq.13.5 is measured westwards from the south, and so is rotated by 180°:
+	var A = math.Mod(atan2yx(sinx(H), cosx(H)*sinx(latitude)-tanx(δ)*cosx(latitude))+180, 360)
+
+	// correct for negative angles
+	if A < 0 {
+		A += 360
+	}
+
+	return HorizontalCoordinate{
+		Altitude: asinx(sinx(latitude)*sinx(δ) + cosx(latitude)*cosx(δ)*cosx(H)),
+		Azimuth:  A,
+	}
+}
@@ -64,6 +64,21 @@ func TestConvertEclipticCoordinateToEquatorialDecAlt(t *testing.T) {
 	}
 }
 
+func TestConvertEquatorialCoordinateToEcliptic(t *testing.T) {
+	// utilising the ecliptic position of the moon on the datetime provided:
+	var ec EclipticCoordinate = EclipticCoordinate{Longitude: 133.162655, Latitude: -3.229126, Δ: 0}
+
+	var got EclipticCoordinate = ConvertEquatorialCoordinateToEcliptic(d, ConvertEclipticCoordinateToEquatorial(d, ec))
+
+	if math.Abs(got.Longitude-ec.Longitude) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.Longitude, ec.Longitude)
+	}
+
+	if math.Abs(got.Latitude-ec.Latitude) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.Latitude, ec.Latitude)
+	}
+}
+
 func TestConvertEquatorialCoordinateTHorizontalAltitude(t *testing.T) {
 	var hz HorizontalCoordinate = ConvertEquatorialCoordinateToHorizontal(datetime, longitude, latitude, EquatorialCoordinate{RightAscension: 88.7929583, Declination: 7.4070639})
 
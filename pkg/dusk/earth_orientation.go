@@ -0,0 +1,384 @@
+package dusk
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type EarthOrientationParameters struct {
+	/*
+		MJD - the modified Julian date of the values (in UTC), i.e., JD - 2400000.5
+	*/
+	MJD float64 `json:"mjd"`
+	/*
+		PolarMotionX - the x coordinate of the celestial intermediate pole, xp (in arcseconds)
+	*/
+	PolarMotionX float64 `json:"x"`
+	/*
+		PolarMotionY - the y coordinate of the celestial intermediate pole, yp (in arcseconds)
+	*/
+	PolarMotionY float64 `json:"y"`
+	/*
+		UT1MinusUTC - the difference UT1 - UTC, or DUT1 (in seconds)
+	*/
+	UT1MinusUTC float64 `json:"dut1"`
+	/*
+		Predicted - whether the values are predictions rather than observations
+	*/
+	Predicted bool `json:"predicted"`
+}
+
+/*
+	EarthOrientationTable is a daily series of Earth orientation parameters, ordered by date.
+*/
+type EarthOrientationTable struct {
+	entries []EarthOrientationParameters
+}
+
+var (
+	earthOrientationMutex sync.RWMutex
+	earthOrientationTable *EarthOrientationTable
+)
+
+/*
+	NewEarthOrientationTable()
+
+	@param entries - the daily Earth orientation parameters, in any order
+	@returns the table of Earth orientation parameters, ordered by date
+*/
+func NewEarthOrientationTable(entries []EarthOrientationParameters) *EarthOrientationTable {
+	var t = &EarthOrientationTable{
+		entries: make([]EarthOrientationParameters, len(entries)),
+	}
+
+	copy(t.entries, entries)
+
+	sort.SliceStable(t.entries, func(i, j int) bool {
+		return t.entries[i].MJD < t.entries[j].MJD
+	})
+
+	return t
+}
+
+/*
+	Len()
+
+	@returns the number of days in the table
+*/
+func (t *EarthOrientationTable) Len() int {
+	return len(t.entries)
+}
+
+/*
+	Range()
+
+	@returns the first and last datetime (in UTC) covered by the table
+*/
+func (t *EarthOrientationTable) Range() (time.Time, time.Time) {
+	if len(t.entries) == 0 {
+		return time.Time{}, time.Time{}
+	}
+
+	return GetUniversalTime(t.entries[0].MJD + 2400000.5), GetUniversalTime(t.entries[len(t.entries)-1].MJD + 2400000.5)
+}
+
+/*
+	Interpolate()
+
+	N.B. UT1 - UTC is interpolated as UT1 - TAI, which (unlike UT1 - UTC) is continuous across leap seconds.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the Earth orientation parameters linearly interpolated to the datetime, or an error if the datetime lies outside the table.
+*/
+func (t *EarthOrientationTable) Interpolate(datetime time.Time) (EarthOrientationParameters, error) {
+	var MJD = GetJulianDate(datetime) - 2400000.5
+
+	var n = len(t.entries)
+
+	if n == 0 || MJD < t.entries[0].MJD || MJD > t.entries[n-1].MJD {
+		return EarthOrientationParameters{}, fmt.Errorf("datetime %s lies outside the Earth orientation data", datetime.UTC().Format(time.RFC3339))
+	}
+
+	// the index of the first entry after the datetime:
+	var i = sort.Search(n, func(i int) bool {
+		return t.entries[i].MJD > MJD
+	})
+
+	if i == n {
+		return t.entries[n-1], nil
+	}
+
+	var a, b = t.entries[i-1], t.entries[i]
+
+	var f = (MJD - a.MJD) / (b.MJD - a.MJD)
+
+	var ΔTa = a.UT1MinusUTC - GetTAIMinusUTC(GetUniversalTime(a.MJD+2400000.5))
+
+	var ΔTb = b.UT1MinusUTC - GetTAIMinusUTC(GetUniversalTime(b.MJD+2400000.5))
+
+	return EarthOrientationParameters{
+		MJD:          MJD,
+		PolarMotionX: a.PolarMotionX + f*(b.PolarMotionX-a.PolarMotionX),
+		PolarMotionY: a.PolarMotionY + f*(b.PolarMotionY-a.PolarMotionY),
+		UT1MinusUTC:  ΔTa + f*(ΔTb-ΔTa) + GetTAIMinusUTC(datetime),
+		Predicted:    a.Predicted || b.Predicted,
+	}, nil
+}
+
+/*
+	SetEarthOrientationTable()
+
+	Sets the Earth orientation parameters used by the time-scale and sidereal time computations, e.g., GetUT1MinusUTC().
+
+	@param t - the table of Earth orientation parameters, or nil to clear it
+*/
+func SetEarthOrientationTable(t *EarthOrientationTable) {
+	earthOrientationMutex.Lock()
+
+	defer earthOrientationMutex.Unlock()
+
+	earthOrientationTable = t
+}
+
+/*
+	GetEarthOrientationParameters()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the Earth orientation parameters interpolated from the table set by SetEarthOrientationTable(), or an error
+	if no table is set or the datetime lies outside of it.
+*/
+func GetEarthOrientationParameters(datetime time.Time) (EarthOrientationParameters, error) {
+	earthOrientationMutex.RLock()
+
+	var t = earthOrientationTable
+
+	earthOrientationMutex.RUnlock()
+
+	if t == nil {
+		return EarthOrientationParameters{}, fmt.Errorf("no Earth orientation data has been set")
+	}
+
+	return t.Interpolate(datetime)
+}
+
+/*
+	GetPolarMotion()
+
+	N.B. falls back to zero (i.e., the pole is taken to coincide with the reference pole) where no data is available.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the coordinates of the pole, xp and yp (in arcseconds)
+*/
+func GetPolarMotion(datetime time.Time) (float64, float64) {
+	eop, err := GetEarthOrientationParameters(datetime)
+
+	if err != nil {
+		return 0, 0
+	}
+
+	return eop.PolarMotionX, eop.PolarMotionY
+}
+
+/*
+	GetPolarMotionCorrectedObserverCoordinate()
+
+	N.B. this is applied by ConvertEquatorialCoordinateToHorizontal(); other routines that take the coordinate of an
+	observer use it as given, and so may opt in by passing the corrected coordinate.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@returns the instantaneous longitude and latitude of the observer, referred to the true pole of rotation (in degrees)
+	@see p.34 of the Explanatory Supplement to the Astronomical Almanac. 1992. Mill Valley, Ca: University Science Books.
+*/
+func GetPolarMotionCorrectedObserverCoordinate(datetime time.Time, longitude float64, latitude float64) (float64, float64) {
+	var xp, yp = GetPolarMotion(datetime)
+
+	// the instantaneous latitude correction, Δφ = xp cos λ - yp sin λ (in degrees):
+	var Δφ = (xp*cosx(longitude) - yp*sinx(longitude)) / 3600
+
+	// the instantaneous longitude correction, Δλ = (xp sin λ + yp cos λ) tan φ (in degrees):
+	var Δλ = (xp*sinx(longitude) + yp*cosx(longitude)) * tanx(latitude) / 3600
+
+	return longitude + Δλ, latitude + Δφ
+}
+
+/*
+	LoadIERSFinals2000A()
+
+	@param path - the path to an IERS "finals2000A" file on local disk, e.g., finals2000A.all or finals2000A.data
+	@returns the table of Earth orientation parameters, or an error.
+*/
+func LoadIERSFinals2000A(path string) (*EarthOrientationTable, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return ReadIERSFinals2000A(f)
+}
+
+/*
+	ReadIERSFinals2000A()
+
+	N.B. the final (Bulletin B) values are used where given, otherwise the rapid service / prediction (Bulletin A) values;
+	rows beyond the end of the predictions (without a value of UT1 - UTC) are skipped.
+
+	@param r - the reader of the fixed-width IERS "finals2000A" file
+	@returns the table of Earth orientation parameters, or an error.
+	@see https://maia.usno.navy.mil/ser7/readme.finals2000A
+*/
+func ReadIERSFinals2000A(r io.Reader) (*EarthOrientationTable, error) {
+	var entries = []EarthOrientationParameters{}
+
+	var scanner = bufio.NewScanner(r)
+
+	var line = 0
+
+	for scanner.Scan() {
+		line++
+
+		var row = scanner.Text()
+
+		if strings.TrimSpace(row) == "" {
+			continue
+		}
+
+		// pad short rows, as trailing blank columns are often stripped:
+		if len(row) < 185 {
+			row += strings.Repeat(" ", 185-len(row))
+		}
+
+		// the Bulletin A UT1 - UTC is blank beyond the end of the predictions:
+		if strings.TrimSpace(row[58:68]) == "" {
+			continue
+		}
+
+		var errs = &fixedWidthErrors{}
+
+		var eop = EarthOrientationParameters{
+			MJD:          errs.float(row, 7, 15),
+			PolarMotionX: errs.optionalFloat(row, 18, 27),
+			PolarMotionY: errs.optionalFloat(row, 37, 46),
+			UT1MinusUTC:  errs.float(row, 58, 68),
+			Predicted:    row[57] == 'P',
+		}
+
+		// prefer the final Bulletin B values, where given:
+		if strings.TrimSpace(row[154:165]) != "" {
+			eop.PolarMotionX = errs.float(row, 134, 144)
+			eop.PolarMotionY = errs.float(row, 144, 154)
+			eop.UT1MinusUTC = errs.float(row, 154, 165)
+			eop.Predicted = false
+		}
+
+		if errs.err != nil {
+			return nil, fmt.Errorf("invalid IERS finals2000A entry on line %d: %w", line, errs.err)
+		}
+
+		entries = append(entries, eop)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewEarthOrientationTable(entries), nil
+}
+
+/*
+	LoadIERSEOPC04()
+
+	@param path - the path to an IERS EOP C04 series file on local disk, e.g., eopc04_IAU2000.62-now
+	@returns the table of Earth orientation parameters, or an error.
+*/
+func LoadIERSEOPC04(path string) (*EarthOrientationTable, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return ReadIERSEOPC04(f)
+}
+
+/*
+	ReadIERSEOPC04()
+
+	N.B. both the EOP 14 C04 layout (YR MM DD MJD x y UT1-UTC ...) and the later EOP 20 C04 layout, with an hour column
+	before the MJD (YR MM DD HH MJD x y UT1-UTC ...), are accepted; header and comment lines are skipped.
+
+	@param r - the reader of the whitespace separated IERS EOP C04 series file
+	@returns the table of Earth orientation parameters, or an error.
+	@see https://hpiers.obspm.fr/iers/eop/eopc04/
+*/
+func ReadIERSEOPC04(r io.Reader) (*EarthOrientationTable, error) {
+	var entries = []EarthOrientationParameters{}
+
+	var scanner = bufio.NewScanner(r)
+
+	var line = 0
+
+	for scanner.Scan() {
+		line++
+
+		var fields = strings.Fields(scanner.Text())
+
+		// data rows begin with a four digit year, everything else is header:
+		if len(fields) < 7 || len(fields[0]) != 4 {
+			continue
+		}
+
+		if _, err := strconv.Atoi(fields[0]); err != nil {
+			continue
+		}
+
+		// the index of the MJD column, which is preceded by an hour column in the EOP 20 C04 layout:
+		var i = 3
+
+		if h, err := strconv.ParseFloat(fields[3], 64); err == nil && h < 24 {
+			i = 4
+		}
+
+		if len(fields) < i+4 {
+			return nil, fmt.Errorf("invalid IERS EOP C04 entry on line %d: too few columns", line)
+		}
+
+		var values [4]float64
+
+		for k := range values {
+			v, err := strconv.ParseFloat(fields[i+k], 64)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid IERS EOP C04 entry on line %d: %w", line, err)
+			}
+
+			values[k] = v
+		}
+
+		entries = append(entries, EarthOrientationParameters{
+			MJD:          values[0],
+			PolarMotionX: values[1],
+			PolarMotionY: values[2],
+			UT1MinusUTC:  values[3],
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewEarthOrientationTable(entries), nil
+}
@@ -0,0 +1,308 @@
+package dusk
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// an excerpt of finals2000A.all, either side of the leap second of 31 December 2016:
+var finals2000A = strings.Join([]string{
+	"161230 57752.00 I  0.071673 0.000091  0.293623 0.000091  I-0.4072838 0.0000064  0.9000 0.0045  I     0.100    0.050    -0.100    0.050  0.071700  0.293600 -0.4073000     0.100    -0.100",
+	"161231 57753.00 I  0.070312 0.000091  0.294818 0.000091  I-0.4081026 0.0000064  0.9000 0.0045  I     0.100    0.050    -0.100    0.050  0.070300  0.294800 -0.4081000     0.100    -0.100",
+	"17 1 1 57754.00 I  0.069025 0.000091  0.295876 0.000091  I 0.5911992 0.0000064  0.9000 0.0045  I     0.100    0.050    -0.100    0.050  0.069000  0.295900  0.5912000     0.100    -0.100",
+	"17 1 2 57755.00 I  0.067806 0.000091  0.296910 0.000091  I 0.5902741 0.0000064  0.9000 0.0045  I     0.100    0.050    -0.100    0.050",
+	"17 1 3 57756.00 P  0.066500 0.000091  0.297900 0.000091  P 0.5894200 0.0000064  0.9000 0.0045  P     0.100    0.050    -0.100    0.050",
+	"17 1 4 57757.00",
+}, "\n")
+
+// an excerpt of eopc04_IAU2000.62-now, in the EOP 14 C04 layout:
+var eopc04 = strings.Join([]string{
+	"                          EARTH ORIENTATION PARAMETER (EOP) PRODUCT CENTER CENTER (PARIS OBSERVATORY)",
+	"",
+	"      Date      MJD      x          y        UT1-UTC       LOD         dX        dY        x Err     y Err   UT1-UTC Err  LOD Err     dX Err       dY Err  ",
+	"                         \"          \"           s           s          \"         \"           \"          \"          s         s            \"           \"",
+	"     (0h UTC)",
+	"",
+	"2016  12  30  57752   0.071702   0.293614  -0.4072912   0.0008995   0.000112  -0.000097   0.000026   0.000025  0.0000080  0.0000099    0.000060    0.000060",
+	"2016  12  31  57753   0.070306   0.294809  -0.4081150   0.0008251   0.000113  -0.000097   0.000026   0.000025  0.0000080  0.0000099    0.000060    0.000060",
+	"2017   1   1  57754   0.069018   0.295885   0.5912011   0.0009022   0.000114  -0.000097   0.000026   0.000025  0.0000080  0.0000099    0.000060    0.000060",
+}, "\n")
+
+func TestReadIERSFinals2000A(t *testing.T) {
+	table, err := ReadIERSFinals2000A(strings.NewReader(finals2000A))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the final row, beyond the end of the predictions, is skipped:
+	if table.Len() != 5 {
+		t.Errorf("got %d, wanted %d", table.Len(), 5)
+	}
+
+	var from, to = table.Range()
+
+	if !from.Equal(time.Date(2016, 12, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("got %q, wanted %q", from, time.Date(2016, 12, 30, 0, 0, 0, 0, time.UTC))
+	}
+
+	if !to.Equal(time.Date(2017, 1, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("got %q, wanted %q", to, time.Date(2017, 1, 3, 0, 0, 0, 0, time.UTC))
+	}
+}
+
+func TestReadIERSFinals2000APrefersBulletinB(t *testing.T) {
+	table, err := ReadIERSFinals2000A(strings.NewReader(finals2000A))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eop, err := table.Interpolate(time.Date(2016, 12, 30, 0, 0, 0, 0, time.UTC))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(eop.UT1MinusUTC+0.4073) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", eop.UT1MinusUTC, -0.4073)
+	}
+
+	if math.Abs(eop.PolarMotionX-0.0717) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", eop.PolarMotionX, 0.0717)
+	}
+}
+
+func TestReadIERSFinals2000APredicted(t *testing.T) {
+	table, err := ReadIERSFinals2000A(strings.NewReader(finals2000A))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eop, err := table.Interpolate(time.Date(2017, 1, 2, 12, 0, 0, 0, time.UTC))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !eop.Predicted {
+		t.Errorf("got %v, wanted %v", eop.Predicted, true)
+	}
+
+	var want = (0.5902741 + 0.58942) / 2
+
+	if math.Abs(eop.UT1MinusUTC-want) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", eop.UT1MinusUTC, want)
+	}
+}
+
+func TestReadIERSFinals2000AInvalid(t *testing.T) {
+	_, err := ReadIERSFinals2000A(strings.NewReader("161230 57752.xx I  0.071673 0.000091  0.293623 0.000091  I-0.4072838"))
+
+	if err == nil {
+		t.Errorf("got nil, wanted an error")
+	}
+}
+
+func TestEarthOrientationTableInterpolateAcrossLeapSecond(t *testing.T) {
+	table, err := ReadIERSFinals2000A(strings.NewReader(finals2000A))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// UT1 - TAI is continuous, whereas UT1 - UTC steps by one second at the leap second:
+	var before = (-0.4081 - 36 + 0.5912 - 37) / 2
+
+	eop, err := table.Interpolate(time.Date(2016, 12, 31, 12, 0, 0, 0, time.UTC))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(eop.UT1MinusUTC-(before+36)) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", eop.UT1MinusUTC, before+36)
+	}
+
+	eop, err = table.Interpolate(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(eop.UT1MinusUTC-0.5912) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", eop.UT1MinusUTC, 0.5912)
+	}
+}
+
+func TestEarthOrientationTableInterpolateOutOfRange(t *testing.T) {
+	table, err := ReadIERSFinals2000A(strings.NewReader(finals2000A))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = table.Interpolate(datetime); err == nil {
+		t.Errorf("got nil, wanted an error")
+	}
+
+	if _, err = table.Interpolate(time.Date(2016, 12, 29, 23, 0, 0, 0, time.UTC)); err == nil {
+		t.Errorf("got nil, wanted an error")
+	}
+}
+
+func TestReadIERSEOPC04(t *testing.T) {
+	table, err := ReadIERSEOPC04(strings.NewReader(eopc04))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if table.Len() != 3 {
+		t.Errorf("got %d, wanted %d", table.Len(), 3)
+	}
+
+	eop, err := table.Interpolate(time.Date(2016, 12, 30, 6, 0, 0, 0, time.UTC))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var want = -0.4072912 + (-0.4081150+0.4072912)/4
+
+	if math.Abs(eop.UT1MinusUTC-want) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", eop.UT1MinusUTC, want)
+	}
+
+	want = 0.293614 + (0.294809-0.293614)/4
+
+	if math.Abs(eop.PolarMotionY-want) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", eop.PolarMotionY, want)
+	}
+}
+
+func TestReadIERSEOPC04WithHourColumn(t *testing.T) {
+	var data = strings.Join([]string{
+		"# YR  MM  DD  HH       MJD        x(\")        y(\")  UT1-UTC(s)       dX(\")      dY(\")",
+		"2016  12  30   0  57752.00    0.071702    0.293614 -0.4072912    0.000112   -0.000097",
+		"2016  12  31   0  57753.00    0.070306    0.294809 -0.4081150    0.000113   -0.000097",
+	}, "\n")
+
+	table, err := ReadIERSEOPC04(strings.NewReader(data))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eop, err := table.Interpolate(time.Date(2016, 12, 31, 0, 0, 0, 0, time.UTC))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(eop.UT1MinusUTC+0.4081150) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", eop.UT1MinusUTC, -0.4081150)
+	}
+
+	if math.Abs(eop.PolarMotionX-0.070306) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", eop.PolarMotionX, 0.070306)
+	}
+}
+
+func TestSetEarthOrientationTable(t *testing.T) {
+	table, err := ReadIERSFinals2000A(strings.NewReader(finals2000A))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var datetime = time.Date(2016, 12, 30, 0, 0, 0, 0, time.UTC)
+
+	var GST = GetGreenwhichSiderealTime(datetime)
+
+	SetEarthOrientationTable(table)
+
+	defer SetEarthOrientationTable(nil)
+
+	if got := GetUT1MinusUTC(datetime); math.Abs(got+0.4073) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", got, -0.4073)
+	}
+
+	if got := GetDeltaT(datetime); math.Abs(got-(32.184+36+0.4073)) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", got, 32.184+36+0.4073)
+	}
+
+	// the sidereal time lags by DUT1 (in sidereal seconds):
+	var got = (GetGreenwhichSiderealTime(datetime) - GST) * 3600
+
+	var want = -0.4073 * 1.002737909
+
+	if math.Abs(got-want) > 0.001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+
+	// outside of the table, DUT1 explicitly falls back to zero:
+	if got := GetUT1MinusUTC(time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC)); got != 0 {
+		t.Errorf("got %f, wanted %f", got, 0.0)
+	}
+}
+
+func TestGetEarthOrientationParametersWithoutTable(t *testing.T) {
+	if _, err := GetEarthOrientationParameters(datetime); err == nil {
+		t.Errorf("got nil, wanted an error")
+	}
+
+	var x, y = GetPolarMotion(datetime)
+
+	if x != 0 || y != 0 {
+		t.Errorf("got %f, %f, wanted %f, %f", x, y, 0.0, 0.0)
+	}
+}
+
+func TestGetPolarMotionCorrectedObserverCoordinate(t *testing.T) {
+	SetEarthOrientationTable(NewEarthOrientationTable([]EarthOrientationParameters{
+		{MJD: 59348, PolarMotionX: 0.1, PolarMotionY: 0.3},
+		{MJD: 59349, PolarMotionX: 0.1, PolarMotionY: 0.3},
+	}))
+
+	defer SetEarthOrientationTable(nil)
+
+	var lon, lat = GetPolarMotionCorrectedObserverCoordinate(datetime, 0, 45)
+
+	if math.Abs(lat-(45+0.1/3600)) > 0.000000001 {
+		t.Errorf("got %f, wanted %f", lat, 45+0.1/3600)
+	}
+
+	if math.Abs(lon-0.3/3600) > 0.000000001 {
+		t.Errorf("got %f, wanted %f", lon, 0.3/3600)
+	}
+}
+
+func TestConvertEquatorialCoordinateToHorizontalPolarMotion(t *testing.T) {
+	var eq = EquatorialCoordinate{RightAscension: 88.7929583, Declination: 7.4070639}
+
+	// with the true pole of rotation displaced by 0.1" and 0.3", the observer is moved accordingly:
+	var lon, lat = longitude + (0.1*sinx(longitude)+0.3*cosx(longitude))*tanx(latitude)/3600, latitude + (0.1*cosx(longitude)-0.3*sinx(longitude))/3600
+
+	var want = ConvertEquatorialCoordinateToHorizontal(datetime, lon, lat, eq)
+
+	SetEarthOrientationTable(NewEarthOrientationTable([]EarthOrientationParameters{
+		{MJD: 59348, PolarMotionX: 0.1, PolarMotionY: 0.3},
+		{MJD: 59349, PolarMotionX: 0.1, PolarMotionY: 0.3},
+	}))
+
+	defer SetEarthOrientationTable(nil)
+
+	var got = ConvertEquatorialCoordinateToHorizontal(datetime, longitude, latitude, eq)
+
+	if math.Abs(got.Altitude-want.Altitude) > 1e-9 {
+		t.Errorf("got %.9f, wanted %.9f", got.Altitude, want.Altitude)
+	}
+
+	if math.Abs(got.Azimuth-want.Azimuth) > 1e-9 {
+		t.Errorf("got %.9f, wanted %.9f", got.Azimuth, want.Azimuth)
+	}
+}
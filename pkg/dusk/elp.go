@@ -0,0 +1,629 @@
+package dusk
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type ELPMPP02Fit int
+
+const (
+	/*
+		ELPMPP02LunarLaserRanging - the constants of ELP/MPP02 fitted to the Lunar Laser Ranging observations
+	*/
+	ELPMPP02LunarLaserRanging = ELPMPP02Fit(iota)
+	/*
+		ELPMPP02DE405 - the constants of ELP/MPP02 fitted to the JPL ephemeris DE405
+	*/
+	ELPMPP02DE405
+)
+
+/*
+	the file names of the ELP/MPP02 series, i.e., of the main problem and the perturbations, for the longitude, latitude
+	and distance respectively
+*/
+var ELPMPP02_MAIN_FILES = [3]string{"ELP_MAIN.S1", "ELP_MAIN.S2", "ELP_MAIN.S3"}
+
+var ELPMPP02_PERTURBATION_FILES = [3]string{"ELP_PERT.S1", "ELP_PERT.S2", "ELP_PERT.S3"}
+
+/*
+	a term of the series, i.e., the amplitude (in arcseconds, or km for the distance) and the polynomial coefficients of
+	the argument (in radians, and radians per Julian century to the power 1-4)
+*/
+type elpTerm struct {
+	amplitude float64
+	argument  [5]float64
+}
+
+/*
+	ELPMPP02 is the semi-analytical lunar theory ELP/MPP02 of Chapront & Francou, evaluated from its series files.
+*/
+type ELPMPP02 struct {
+	// the mean longitude of the Moon, W1 (in radians), as polynomial coefficients in Julian centuries:
+	w1 [5]float64
+	// the main problem, for the longitude, latitude and distance:
+	main [3][]elpTerm
+	// the perturbations, for the longitude, latitude and distance, for each power of time (0-3):
+	perturbations [3][4][]elpTerm
+}
+
+/*
+	elpArguments are the fundamental arguments of ELP/MPP02, as polynomial coefficients in Julian centuries (in radians)
+*/
+type elpArguments struct {
+	w1, w2, w3, earth, perihelion [5]float64
+	// the Delaunay arguments D, l', l and F:
+	delaunay [4][5]float64
+	// the mean longitudes of the planets from Mercury to Neptune:
+	planets [8][2]float64
+	// the argument of the precession, ζ:
+	ζ [5]float64
+	// the corrections to the constants of the main problem:
+	δν, δe, δγ, δn, δeʹ float64
+}
+
+/*
+	the ratio of the mean motions of the Sun and the Moon, m, and the constant α of the derivatives of the main problem
+*/
+const (
+	elpMeanMotionRatio = 0.074801329
+	elpAlpha           = 0.002571881
+)
+
+/*
+	getELPMPP02Radians()
+
+	@param d - the degrees
+	@param m - the arcminutes
+	@param s - the arcseconds
+	@returns the angle (in radians)
+*/
+func getELPMPP02Radians(d float64, m float64, s float64) float64 {
+	return (d + m/60 + s/3600) * math.Pi / 180
+}
+
+/*
+	getELPMPP02Arguments()
+
+	@param fit - the fit of the constants, e.g., ELPMPP02DE405
+	@returns the fundamental arguments and the corrections to the constants of the main problem
+	@see Chapront, J. & Francou, G. 2003. The lunar theory ELP revisited. Introduction of new planetary perturbations. A&A 404, 735-742.
+*/
+func getELPMPP02Arguments(fit ELPMPP02Fit) elpArguments {
+	// the number of arcseconds in one radian:
+	var rad = 648000 / math.Pi
+
+	// the corrections to the constants, i.e., of the fit to the Lunar Laser Ranging observations:
+	var Δw10, Δw20, Δw30, ΔT0, Δϖ = -0.10525, 0.16826, -0.10760, -0.04012, -0.04854
+
+	var Δw11, Δγ, Δe, ΔT1, Δeʹ = -0.32311, 0.00069, 0.00005, 0.01442, 0.00226
+
+	var Δw21, Δw31, Δw12 = 0.08017, -0.04317, -0.03794
+
+	var Δw13, Δw14, Δw22, Δw23, Δw32, Δw33 = 0.0, 0.0, 0.0, 0.0, 0.0, 0.0
+
+	if fit == ELPMPP02DE405 {
+		Δw10, Δw20, Δw30, ΔT0, Δϖ = -0.07008, 0.20794, -0.07215, -0.00033, -0.00749
+
+		Δw11, Δγ, Δe, ΔT1, Δeʹ = -0.35106, 0.00085, -0.00006, 0.00732, 0.00224
+
+		Δw21, Δw31, Δw12 = 0.08017, -0.04317, -0.03743
+
+		Δw13, Δw14, Δw22, Δw23, Δw32, Δw33 = -0.00018865, -0.00001024, 0.00470602, -0.00025213, -0.00261070, -0.00010712
+	}
+
+	var a = elpArguments{}
+
+	// the mean longitude of the Moon:
+	a.w1 = [5]float64{getELPMPP02Radians(218, 18, 59.95571+Δw10), (1732559343.73604 + Δw11) / rad, (-6.8084 + Δw12) / rad, (0.66040e-2 + Δw13) / rad, (-0.31690e-4 + Δw14) / rad}
+
+	// the mean longitude of the lunar perigee:
+	a.w2 = [5]float64{getELPMPP02Radians(83, 21, 11.67475+Δw20), (14643420.3171 + Δw21) / rad, (-38.2631 + Δw22) / rad, (-0.45047e-1 + Δw23) / rad, 0.21301e-3 / rad}
+
+	// the mean longitude of the lunar ascending node:
+	a.w3 = [5]float64{getELPMPP02Radians(125, 2, 40.39816+Δw30), (-6967919.5383 + Δw31) / rad, (6.3590 + Δw32) / rad, (0.76250e-2 + Δw33) / rad, -0.35860e-4 / rad}
+
+	// the mean longitude of the Earth-Moon barycenter:
+	a.earth = [5]float64{getELPMPP02Radians(100, 27, 59.13885+ΔT0), (129597742.29300 + ΔT1) / rad, -0.020200 / rad, 0.90000e-5 / rad, 0.15000e-6 / rad}
+
+	// the mean longitude of the perihelion of the Earth-Moon barycenter:
+	a.perihelion = [5]float64{getELPMPP02Radians(102, 56, 14.45766+Δϖ), 1161.24342 / rad, 0.529265 / rad, -0.11814e-3 / rad, 0.11379e-4 / rad}
+
+	for k := 0; k < 5; k++ {
+		// D, the mean elongation of the Moon from the Sun:
+		a.delaunay[0][k] = a.w1[k] - a.earth[k]
+		// l', the mean anomaly of the Earth-Moon barycenter:
+		a.delaunay[1][k] = a.earth[k] - a.perihelion[k]
+		// l, the mean anomaly of the Moon:
+		a.delaunay[2][k] = a.w1[k] - a.w2[k]
+		// F, the argument of latitude of the Moon:
+		a.delaunay[3][k] = a.w1[k] - a.w3[k]
+	}
+
+	a.delaunay[0][0] += math.Pi
+
+	a.planets = [8][2]float64{
+		{getELPMPP02Radians(252, 15, 3.216919), 538101628.66888 / rad},
+		{getELPMPP02Radians(181, 58, 44.758419), 210664136.45777 / rad},
+		{getELPMPP02Radians(100, 27, 59.138850), 129597742.29300 / rad},
+		{getELPMPP02Radians(355, 26, 3.642778), 68905077.65936 / rad},
+		{getELPMPP02Radians(34, 21, 5.379392), 10925660.57335 / rad},
+		{getELPMPP02Radians(50, 4, 38.902495), 4399609.33632 / rad},
+		{getELPMPP02Radians(314, 3, 4.354234), 1542482.57845 / rad},
+		{getELPMPP02Radians(304, 20, 56.808371), 786547.89700 / rad},
+	}
+
+	// the argument of the precession, i.e., the mean longitude of the Moon referred to the departure point of J2000:
+	a.ζ = a.w1
+
+	a.ζ[1] += 5029.0966 / rad
+
+	a.δν = (0.55604 + Δw11) / rad / a.w1[1]
+	a.δe = (0.01789 + Δe) / rad
+	a.δγ = (-0.08066 + Δγ) / rad
+	a.δn = (-0.06424 + ΔT1) / rad / a.w1[1]
+	a.δeʹ = (-0.12879 + Δeʹ) / rad
+
+	return a
+}
+
+/*
+	LoadELPMPP02()
+
+	@param directory - the directory on local disk of the ELP/MPP02 series files, i.e., ELP_MAIN.S1-3 and ELP_PERT.S1-3
+	@param fit - the fit of the constants, e.g., ELPMPP02DE405
+	@returns the ELP/MPP02 lunar theory, or an error.
+	@see ftp://cyrano-se.obspm.fr/pub/2_lunar_solutions/2_elpmpp02/
+*/
+func LoadELPMPP02(directory string, fit ELPMPP02Fit) (*ELPMPP02, error) {
+	var main, perturbations [3]io.Reader
+
+	for i := 0; i < 3; i++ {
+		m, err := os.Open(filepath.Join(directory, ELPMPP02_MAIN_FILES[i]))
+
+		if err != nil {
+			return nil, err
+		}
+
+		defer m.Close()
+
+		p, err := os.Open(filepath.Join(directory, ELPMPP02_PERTURBATION_FILES[i]))
+
+		if err != nil {
+			return nil, err
+		}
+
+		defer p.Close()
+
+		main[i], perturbations[i] = m, p
+	}
+
+	return ReadELPMPP02(main, perturbations, fit)
+}
+
+/*
+	ReadELPMPP02()
+
+	@param main - the readers of the main problem series, i.e., ELP_MAIN.S1, ELP_MAIN.S2 and ELP_MAIN.S3
+	@param perturbations - the readers of the perturbation series, i.e., ELP_PERT.S1, ELP_PERT.S2 and ELP_PERT.S3
+	@param fit - the fit of the constants, e.g., ELPMPP02DE405
+	@returns the ELP/MPP02 lunar theory, or an error.
+*/
+func ReadELPMPP02(main [3]io.Reader, perturbations [3]io.Reader, fit ELPMPP02Fit) (*ELPMPP02, error) {
+	var a = getELPMPP02Arguments(fit)
+
+	var e = &ELPMPP02{
+		w1: a.w1,
+	}
+
+	for i := 0; i < 3; i++ {
+		terms, err := readELPMPP02MainProblem(main[i], i, a)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid ELP/MPP02 series %s: %w", ELPMPP02_MAIN_FILES[i], err)
+		}
+
+		e.main[i] = terms
+
+		blocks, err := readELPMPP02Perturbations(perturbations[i], a)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid ELP/MPP02 series %s: %w", ELPMPP02_PERTURBATION_FILES[i], err)
+		}
+
+		e.perturbations[i] = blocks
+	}
+
+	return e, nil
+}
+
+/*
+	readELPMPP02MainProblem()
+
+	Each term is given by the multipliers of the Delaunay arguments D, l', l and F (4i3), the amplitude A and the
+	derivatives B1-B5 (or B6) of the amplitude with respect to the constants of the theory.
+
+	@param r - the reader of the main problem series
+	@param series - the index of the series, i.e., 0 for the longitude, 1 for the latitude and 2 for the distance
+	@param a - the fundamental arguments and the corrections to the constants
+	@returns the terms of the main problem, corrected for the fit of the constants, or an error.
+*/
+func readELPMPP02MainProblem(r io.Reader, series int, a elpArguments) ([]elpTerm, error) {
+	var terms = []elpTerm{}
+
+	var scanner = bufio.NewScanner(r)
+
+	var line = 0
+
+	for scanner.Scan() {
+		line++
+
+		var row = strings.TrimRight(scanner.Text(), " \r")
+
+		if strings.TrimSpace(row) == "" {
+			continue
+		}
+
+		// the header of the series, e.g., "MAIN PROBLEM. LONGITUDE", is skipped:
+		if isELPMPP02Header(row) {
+			continue
+		}
+
+		if len(row) < 12 {
+			return nil, fmt.Errorf("line %d: invalid main problem term", line)
+		}
+
+		var multipliers = [4]float64{}
+
+		for j := 0; j < 4; j++ {
+			v, err := strconv.Atoi(strings.TrimSpace(row[j*3 : j*3+3]))
+
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+
+			multipliers[j] = float64(v)
+		}
+
+		var fields = strings.Fields(row[12:])
+
+		if len(fields) < 6 {
+			return nil, fmt.Errorf("line %d: expected at least 6 coefficients, got %d", line, len(fields))
+		}
+
+		var coefficients = [6]float64{}
+
+		for j := 0; j < 6; j++ {
+			v, err := parseELPMPP02Float(fields[j])
+
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+
+			coefficients[j] = v
+		}
+
+		var A = coefficients[0]
+
+		// the distance is scaled by the ratio of the corrected and uncorrected semi-major axes, i.e., (1 + δν)^(-2/3):
+		if series == 2 {
+			A -= 2 * A * a.δν / 3
+		}
+
+		// the correction of the amplitude for the fit of the constants of the main problem:
+		var B1 = coefficients[1] + 2*elpAlpha/(3*elpMeanMotionRatio)*coefficients[5]
+
+		var term = elpTerm{
+			amplitude: A + B1*(a.δn-elpMeanMotionRatio*a.δν) + coefficients[2]*a.δγ + coefficients[3]*a.δe + coefficients[4]*a.δeʹ,
+		}
+
+		for k := 0; k < 5; k++ {
+			for j := 0; j < 4; j++ {
+				term.argument[k] += multipliers[j] * a.delaunay[j][k]
+			}
+		}
+
+		// the distance is a series of cosines:
+		if series == 2 {
+			term.argument[0] += math.Pi / 2
+		}
+
+		terms = append(terms, term)
+	}
+
+	return terms, scanner.Err()
+}
+
+/*
+	readELPMPP02Perturbations()
+
+	Each block of terms, for the powers of time 0-3, follows a header line; each term is given by its sine and cosine
+	amplitudes S and C, and the multipliers (13i3) of the Delaunay arguments D, l', l and F, the mean longitudes of the
+	planets from Mercury to Neptune, and the argument of the precession ζ.
+
+	@param r - the reader of the perturbation series
+	@param a - the fundamental arguments and the corrections to the constants
+	@returns the terms of the perturbations, for each power of time, or an error.
+*/
+func readELPMPP02Perturbations(r io.Reader, a elpArguments) ([4][]elpTerm, error) {
+	var blocks = [4][]elpTerm{}
+
+	var scanner = bufio.NewScanner(r)
+
+	var line = 0
+
+	var power = -1
+
+	for scanner.Scan() {
+		line++
+
+		var row = strings.TrimRight(scanner.Text(), " \r")
+
+		if strings.TrimSpace(row) == "" {
+			continue
+		}
+
+		// each header, e.g., "PERTURBATIONS. LONGITUDE", begins the block of the next power of time:
+		if isELPMPP02Header(row) {
+			power++
+
+			if power > 3 {
+				return blocks, fmt.Errorf("line %d: expected at most 4 blocks of terms", line)
+			}
+
+			continue
+		}
+
+		if power < 0 || len(row) < 39 {
+			return blocks, fmt.Errorf("line %d: invalid perturbation term", line)
+		}
+
+		var fields = strings.Fields(row[:len(row)-39])
+
+		if len(fields) < 3 {
+			return blocks, fmt.Errorf("line %d: expected the sine and cosine amplitudes", line)
+		}
+
+		S, err := parseELPMPP02Float(fields[1])
+
+		if err != nil {
+			return blocks, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		C, err := parseELPMPP02Float(fields[2])
+
+		if err != nil {
+			return blocks, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		var multipliers = [13]float64{}
+
+		var ints = row[len(row)-39:]
+
+		for j := 0; j < 13; j++ {
+			v, err := strconv.Atoi(strings.TrimSpace(ints[j*3 : j*3+3]))
+
+			if err != nil {
+				return blocks, fmt.Errorf("line %d: %w", line, err)
+			}
+
+			multipliers[j] = float64(v)
+		}
+
+		// the term S sin(φ) + C cos(φ) is expressed as a single sine, of amplitude √(S² + C²) and phase atan2(C, S):
+		var term = elpTerm{
+			amplitude: math.Sqrt(S*S + C*C),
+		}
+
+		term.argument[0] = math.Atan2(C, S)
+
+		for k := 0; k < 5; k++ {
+			for j := 0; j < 4; j++ {
+				term.argument[k] += multipliers[j] * a.delaunay[j][k]
+			}
+
+			term.argument[k] += multipliers[12] * a.ζ[k]
+		}
+
+		for j := 0; j < 8; j++ {
+			term.argument[0] += multipliers[4+j] * a.planets[j][0]
+			term.argument[1] += multipliers[4+j] * a.planets[j][1]
+		}
+
+		blocks[power] = append(blocks[power], term)
+	}
+
+	return blocks, scanner.Err()
+}
+
+/*
+	isELPMPP02Header()
+
+	@param row - the line of the series file
+	@returns whether the line is a header, i.e., begins with a letter, e.g., "PERTURBATIONS. LONGITUDE"
+*/
+func isELPMPP02Header(row string) bool {
+	var s = strings.TrimSpace(row)
+
+	return s != "" && unicode.IsLetter(rune(s[0]))
+}
+
+/*
+	parseELPMPP02Float()
+
+	@param s - the number, in either the E or the Fortran D exponent notation, e.g., "-0.1034412385980D+01"
+	@returns the number, or an error.
+*/
+func parseELPMPP02Float(s string) (float64, error) {
+	return strconv.ParseFloat(strings.Replace(strings.Replace(s, "D", "E", 1), "d", "e", 1), 64)
+}
+
+/*
+	getELPMPP02Series()
+
+	@param terms - the terms of the series
+	@param t - the powers of the number of Julian centuries since J2000, i.e., [1, t, t², t³, t⁴]
+	@returns the sum of the series
+*/
+func getELPMPP02Series(terms []elpTerm, t [5]float64) float64 {
+	var sum float64 = 0
+
+	for _, term := range terms {
+		var φ = term.argument[0] + term.argument[1]*t[1] + term.argument[2]*t[2] + term.argument[3]*t[3] + term.argument[4]*t[4]
+
+		sum += term.amplitude * math.Sin(φ)
+	}
+
+	return sum
+}
+
+/*
+	the coefficients of the ecliptic pole P and Q of Laskar, in Julian centuries to the power 1-5, for the rotation from
+	the mean ecliptic of date to the inertial mean ecliptic of J2000
+
+	@see Chapront, J. & Francou, G. 2003. The lunar theory ELP revisited. Introduction of new planetary perturbations. A&A 404, 735-742.
+*/
+var (
+	elpLaskarP = [5]float64{0.10180391e-4, 0.47020439e-6, -0.5417367e-9, -0.2507948e-11, 0.463486e-14}
+	elpLaskarQ = [5]float64{-0.113469002e-3, 0.12372674e-6, 0.1265417e-8, -0.1371808e-11, -0.320334e-14}
+)
+
+/*
+	getELPMPP02GeneralPrecession()
+
+	@param T - the number of Julian centuries since J2000 (in TDB)
+	@returns the general precession in longitude p_A (in arcseconds), i.e., from the departure point of J2000 to the
+	mean equinox of date
+	@see Simon, J.L. et al. 1994. Numerical expressions for precession formulae and mean elements for the Moon and the planets. A&A 282, 663-683.
+*/
+func getELPMPP02GeneralPrecession(T float64) float64 {
+	return 5029.0966*T + 1.1120*T*T + 0.000077*T*T*T - 0.00002353*T*T*T*T
+}
+
+/*
+	getSphericalPosition()
+
+	@param T - the number of Julian centuries since J2000 (in TDB)
+	@returns the longitude and latitude (in radians) of the Moon, referred to the mean ecliptic of date and the departure
+	point of J2000, and the distance (in km) between the centers of the Earth and Moon
+*/
+func (e *ELPMPP02) getSphericalPosition(T float64) (float64, float64, float64) {
+	var t = [5]float64{1, T, T * T, T * T * T, T * T * T * T}
+
+	// the number of arcseconds in one radian:
+	var rad = 648000 / math.Pi
+
+	var v = [3]float64{}
+
+	for i := 0; i < 3; i++ {
+		v[i] = getELPMPP02Series(e.main[i], t)
+
+		for power := 0; power < 4; power++ {
+			v[i] += getELPMPP02Series(e.perturbations[i][power], t) * t[power]
+		}
+	}
+
+	// the longitude is the mean longitude of the Moon, W1, plus the periodic terms:
+	var V = v[0]/rad + e.w1[0] + e.w1[1]*t[1] + e.w1[2]*t[2] + e.w1[3]*t[3] + e.w1[4]*t[4]
+
+	// the distance is scaled from the semi-major axis of ELP to that of DE405:
+	return V, v[1] / rad, v[2] * 384747.961370173 / 384747.980674318
+}
+
+/*
+	GetLunarEclipticPosition()
+
+	N.B. the longitude and latitude are referred to the mean ecliptic and equinox of date, i.e., the longitude of the
+	theory (measured from the departure point of J2000) is corrected for the general precession in longitude, p_A, and
+	the distance is that between the centers of the Earth and Moon.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the geocentric ecliptic coodinate (λ - geocentric longitude, β - geocentric latidude and Δ distance between centers of the Earth and Moon, in km) of the Moon.
+*/
+func (e *ELPMPP02) GetLunarEclipticPosition(datetime time.Time) EclipticCoordinate {
+	var T = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
+
+	var V, U, r = e.getSphericalPosition(T)
+
+	var λ = math.Mod(V*180/math.Pi+getELPMPP02GeneralPrecession(T)/3600, 360)
+
+	// correct for negative angles
+	if λ < 0 {
+		λ += 360
+	}
+
+	return EclipticCoordinate{
+		Longitude: λ,
+		Latitude:  U * 180 / math.Pi,
+		Δ:         r,
+	}
+}
+
+/*
+	getRectangularPositionJ2000()
+
+	@param T - the number of Julian centuries since J2000 (in TDB)
+	@returns the geocentric rectangular coordinate (X, Y, Z in km) of the Moon, referred to the inertial mean ecliptic
+	and equinox of J2000, as given by the reference implementation ELPMPP02.for
+*/
+func (e *ELPMPP02) getRectangularPositionJ2000(T float64) [3]float64 {
+	var V, U, r = e.getSphericalPosition(T)
+
+	var x = [3]float64{r * math.Cos(U) * math.Cos(V), r * math.Cos(U) * math.Sin(V), r * math.Sin(U)}
+
+	var P, Q float64 = 0, 0
+
+	for i := 4; i >= 0; i-- {
+		P = P*T + elpLaskarP[i]
+		Q = Q*T + elpLaskarQ[i]
+	}
+
+	P, Q = P*T, Q*T
+
+	// the rotation of Laskar, from the mean ecliptic of date to the inertial mean ecliptic of J2000:
+	var ra = 2 * math.Sqrt(1-P*P-Q*Q)
+
+	var PQ, P2, Q2 = 2 * P * Q, 1 - 2*P*P, 1 - 2*Q*Q
+
+	return [3]float64{
+		P2*x[0] + PQ*x[1] + P*ra*x[2],
+		PQ*x[0] + Q2*x[1] - Q*ra*x[2],
+		-P*ra*x[0] + Q*ra*x[1] + (P2+Q2-1)*x[2],
+	}
+}
+
+/*
+	GetLunarRectangularPositionJ2000()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the geocentric rectangular coordinate (X, Y, Z in km) of the Moon, referred to the inertial mean ecliptic
+	and equinox of J2000
+*/
+func (e *ELPMPP02) GetLunarRectangularPositionJ2000(datetime time.Time) [3]float64 {
+	return e.getRectangularPositionJ2000(GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime))
+}
+
+/*
+	GetEclipticPosition()
+
+	N.B. the Moon is given by ELP/MPP02, whereas the Sun and the planets are given by MeeusEphemeris{}.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param body - the body of type Body, e.g., BodyMoon
+	@returns the apparent geocentric ecliptic coordinate (λ, β, Δ in km) of the body, referred to the true equinox of date
+*/
+func (e *ELPMPP02) GetEclipticPosition(datetime time.Time, body Body) (EclipticCoordinate, error) {
+	if body == BodyMoon {
+		return getApparentEclipticPosition(datetime, body, e.GetLunarEclipticPosition(datetime)), nil
+	}
+
+	return MeeusEphemeris{}.GetEclipticPosition(datetime, body)
+}
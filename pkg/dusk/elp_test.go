@@ -0,0 +1,261 @@
+package dusk
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+/*
+	the principal terms of the main problem, i.e., the multipliers of D, l', l and F and the amplitude (in arcseconds, or km)
+
+	@see Table 47.A & 47.B p.339 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+var elpTestMainProblem = [3][]struct {
+	multipliers [4]int
+	A           float64
+}{
+	{
+		{[4]int{0, 0, 1, 0}, 22639.58578},
+		{[4]int{2, 0, -1, 0}, 4586.43830},
+		{[4]int{2, 0, 0, 0}, 2369.91394},
+		{[4]int{0, 0, 2, 0}, 769.02571},
+		{[4]int{0, 1, 0, 0}, -666.41786},
+		{[4]int{0, 0, 0, 2}, -411.60287},
+	},
+	{
+		{[4]int{0, 0, 0, 1}, 18461.24006},
+		{[4]int{0, 0, 1, 1}, 1010.16707},
+		{[4]int{0, 0, 1, -1}, 999.69358},
+		{[4]int{2, 0, 0, -1}, 623.65243},
+		{[4]int{2, 0, -1, 1}, 199.48374},
+		{[4]int{2, 0, -1, -1}, 166.57528},
+		{[4]int{2, 0, 0, 1}, 117.26069},
+		{[4]int{0, 0, 2, 1}, 61.91195},
+	},
+	{
+		{[4]int{0, 0, 0, 0}, 385000.52719},
+		{[4]int{0, 0, 1, 0}, -20905.32206},
+		{[4]int{2, 0, -1, 0}, -3699.10468},
+		{[4]int{2, 0, 0, 0}, -2955.96651},
+		{[4]int{0, 0, 2, 0}, -569.92512},
+	},
+}
+
+var elpTestHeaders = [3]string{"LONGITUDE", "LATITUDE", "DISTANCE"}
+
+func newELPMPP02TestMainProblem(series int) string {
+	var lines = []string{fmt.Sprintf(" MAIN PROBLEM. %s%15d", elpTestHeaders[series], len(elpTestMainProblem[series]))}
+
+	for _, term := range elpTestMainProblem[series] {
+		var m = term.multipliers
+
+		lines = append(lines, fmt.Sprintf("%3d%3d%3d%3d  %13.5f%12.2f%12.2f%12.2f%12.2f%12.2f%12.2f", m[0], m[1], m[2], m[3], term.A, 0.0, 0.0, 0.0, 0.0, 0.0, 0.0))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func newELPMPP02TestPerturbations(series int, terms map[int][]string) string {
+	var lines = []string{}
+
+	for power := 0; power < 4; power++ {
+		lines = append(lines, fmt.Sprintf(" PERTURBATIONS. %s. T%d%10d%10d", elpTestHeaders[series], power, len(terms[power]), power))
+
+		lines = append(lines, terms[power]...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func newELPMPP02Test(t *testing.T, perturbations [3]map[int][]string) *ELPMPP02 {
+	var main, perts [3]io.Reader
+
+	for i := 0; i < 3; i++ {
+		main[i] = strings.NewReader(newELPMPP02TestMainProblem(i))
+		perts[i] = strings.NewReader(newELPMPP02TestPerturbations(i, perturbations[i]))
+	}
+
+	e, err := ReadELPMPP02(main, perts, ELPMPP02DE405)
+
+	if err != nil {
+		t.Fatalf("got %q", err)
+	}
+
+	return e
+}
+
+func TestReadELPMPP02(t *testing.T) {
+	var e = newELPMPP02Test(t, [3]map[int][]string{})
+
+	if len(e.main[0]) != 6 || len(e.main[1]) != 8 || len(e.main[2]) != 5 {
+		t.Errorf("got %d, %d & %d terms, wanted 6, 8 & 5", len(e.main[0]), len(e.main[1]), len(e.main[2]))
+	}
+}
+
+func TestReadELPMPP02Invalid(t *testing.T) {
+	var main, perts [3]io.Reader
+
+	for i := 0; i < 3; i++ {
+		main[i] = strings.NewReader(" MAIN PROBLEM.\n  0  0  x  0  1.0")
+		perts[i] = strings.NewReader("")
+	}
+
+	if _, err := ReadELPMPP02(main, perts, ELPMPP02LunarLaserRanging); err == nil {
+		t.Errorf("expected an error for an invalid series")
+	}
+}
+
+func TestELPMPP02GetLunarEclipticPosition(t *testing.T) {
+	var e = newELPMPP02Test(t, [3]map[int][]string{})
+
+	var got = e.GetLunarEclipticPosition(d)
+
+	var want = GetLunarEclipticPosition(d)
+
+	// the principal terms alone agree with the truncated Meeus series to within the sum of the remaining terms:
+	if math.Abs(got.Longitude-want.Longitude) > 0.1 {
+		t.Errorf("got %f, wanted %f", got.Longitude, want.Longitude)
+	}
+
+	if math.Abs(got.Latitude-want.Latitude) > 0.05 {
+		t.Errorf("got %f, wanted %f", got.Latitude, want.Latitude)
+	}
+
+	if math.Abs(got.Δ-want.Δ) > 300 {
+		t.Errorf("got %f, wanted %f", got.Δ, want.Δ)
+	}
+}
+
+func TestELPMPP02GetLunarEclipticPositionIsReferredToTheEquinoxOfDate(t *testing.T) {
+	// without the periodic terms, the longitude is the mean longitude of the Moon, W1, corrected for the precession:
+	var e = &ELPMPP02{w1: getELPMPP02Arguments(ELPMPP02DE405).w1}
+
+	var datetimes = []time.Time{
+		time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, datetime := range datetimes {
+		var got = e.GetLunarEclipticPosition(datetime).Longitude
+
+		// the mean longitude of the Moon of Meeus is referred to the mean equinox of date:
+		var want = GetLunarMeanLongitude(GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime))
+
+		var Δ = math.Mod(got-want+540, 360) - 180
+
+		// the fits differ by a few hundredths of an arcsecond in T², i.e., by a few arcseconds over ten centuries:
+		if math.Abs(Δ) > 0.005 {
+			t.Errorf("%s: got %f, wanted %f", datetime.Format("2006-01-02"), got, want)
+		}
+	}
+}
+
+func TestELPMPP02GetLunarRectangularPositionJ2000(t *testing.T) {
+	// the series files of ELP/MPP02 are not distributed with the package, and so are only tested where provided:
+	var directory = os.Getenv("ELPMPP02_DIRECTORY")
+
+	if directory == "" {
+		t.Skip("ELPMPP02_DIRECTORY is not set")
+	}
+
+	e, err := LoadELPMPP02(directory, ELPMPP02LunarLaserRanging)
+
+	if err != nil {
+		t.Fatalf("got %q", err)
+	}
+
+	// the reference output of ELPMPP02.for, fitted to the Lunar Laser Ranging observations (in TDB, and km):
+	var tests = []struct {
+		JD   float64
+		want [3]float64
+	}{
+		{2444239.5, [3]float64{43890.2823, 381188.7274, -31633.3816}},
+		{2446239.5, [3]float64{-313664.5962, 212007.2720, 33744.7523}},
+		{2448239.5, [3]float64{-273220.0606, -296859.7660, -34604.3530}},
+		{2450239.5, [3]float64{171613.1407, -318097.3339, 31293.5553}},
+		{2452239.5, [3]float64{396530.0005, 47487.9327, -36085.3066}},
+	}
+
+	for _, tt := range tests {
+		var got = e.getRectangularPositionJ2000((tt.JD - J2000) / 36525)
+
+		for i := range got {
+			if math.Abs(got[i]-tt.want[i]) > 0.01 {
+				t.Errorf("JD %.1f: got %v, wanted %v", tt.JD, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestELPMPP02Perturbations(t *testing.T) {
+	var e = newELPMPP02Test(t, [3]map[int][]string{})
+
+	// a term in t of 1″ in the cosine of the argument of the precession, ζ, i.e., (S, C) = (0, 1):
+	var p = newELPMPP02Test(t, [3]map[int][]string{
+		{1: {"    1  0.0000000000000D+00  0.1000000000000D+01  0  0  0  0  0  0  0  0  0  0  0  0  1"}},
+	})
+
+	if len(p.perturbations[0][1]) != 1 {
+		t.Fatalf("got %d, wanted %d", len(p.perturbations[0][1]), 1)
+	}
+
+	var datetime = time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var T = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
+
+	var a = getELPMPP02Arguments(ELPMPP02DE405)
+
+	var ζ = a.ζ[0] + a.ζ[1]*T + a.ζ[2]*T*T + a.ζ[3]*T*T*T + a.ζ[4]*T*T*T*T
+
+	var got = (p.GetLunarEclipticPosition(datetime).Longitude - e.GetLunarEclipticPosition(datetime).Longitude) * 3600
+
+	if math.Abs(got-T*math.Cos(ζ)) > 1e-6 {
+		t.Errorf("got %f, wanted %f", got, T*math.Cos(ζ))
+	}
+}
+
+func TestLoadELPMPP02(t *testing.T) {
+	var directory = t.TempDir()
+
+	for i := 0; i < 3; i++ {
+		if err := os.WriteFile(filepath.Join(directory, ELPMPP02_MAIN_FILES[i]), []byte(newELPMPP02TestMainProblem(i)), 0o644); err != nil {
+			t.Fatalf("got %q", err)
+		}
+
+		if err := os.WriteFile(filepath.Join(directory, ELPMPP02_PERTURBATION_FILES[i]), []byte(newELPMPP02TestPerturbations(i, nil)), 0o644); err != nil {
+			t.Fatalf("got %q", err)
+		}
+	}
+
+	e, err := LoadELPMPP02(directory, ELPMPP02DE405)
+
+	if err != nil {
+		t.Fatalf("got %q", err)
+	}
+
+	// the theory is a selectable lunar model, as a backend of the rise, set and phase routines:
+	phase, err := GetLunarPhaseForEphemeris(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC), e)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if math.Abs(phase.Illumination-82) > 3 {
+		t.Errorf("got %f, wanted %f", phase.Illumination, 82.0)
+	}
+
+	if _, err := LoadELPMPP02(t.TempDir(), ELPMPP02DE405); err == nil {
+		t.Errorf("expected an error for a missing series")
+	}
+}
@@ -0,0 +1,311 @@
+package dusk
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+type Body int
+
+const (
+	BodySun = Body(iota)
+	BodyMoon
+	BodyMercury
+	BodyVenus
+	BodyMars
+	BodyJupiter
+	BodySaturn
+	BodyUranus
+	BodyNeptune
+)
+
+var bodyNames = [...]string{
+	BodySun:     "Sun",
+	BodyMoon:    "Moon",
+	BodyMercury: "Mercury",
+	BodyVenus:   "Venus",
+	BodyMars:    "Mars",
+	BodyJupiter: "Jupiter",
+	BodySaturn:  "Saturn",
+	BodyUranus:  "Uranus",
+	BodyNeptune: "Neptune",
+}
+
+var bodyPlanets = map[Body]Planet{
+	BodyMercury: Mercury,
+	BodyVenus:   Venus,
+	BodyMars:    Mars,
+	BodyJupiter: Jupiter,
+	BodySaturn:  Saturn,
+	BodyUranus:  Uranus,
+	BodyNeptune: Neptune,
+}
+
+/*
+	String()
+
+	@returns the English name of the body, e.g., "Moon"
+*/
+func (b Body) String() string {
+	if b < BodySun || b > BodyNeptune {
+		return "Unknown"
+	}
+
+	return bodyNames[b]
+}
+
+/*
+	Ephemeris is a source of the positions of the Sun, Moon and planets, allowing speed to be traded for accuracy.
+*/
+type Ephemeris interface {
+	/*
+		GetEclipticPosition()
+
+		@param datetime - the datetime of the observer (in UTC)
+		@param body - the body of type Body, e.g., BodyMoon
+		@returns the apparent geocentric ecliptic coordinate (λ, β, Δ in km) of the body, referred to the true equinox of
+		date, i.e., corrected for nutation and aberration, or an error if the ephemeris does not cover the body or the datetime.
+		Hence, the equatorial coordinate of ConvertEclipticCoordinateToEquatorial() is paired with the apparent sidereal time.
+	*/
+	GetEclipticPosition(datetime time.Time, body Body) (EclipticCoordinate, error)
+}
+
+/*
+	LawrenceEphemeris is the low precision ephemeris of Lawrence, i.e., the Sun of GetSolarEclipticPosition() and the
+	Moon of GetLunarEclipticPositionLawrence(), with the planets from their Keplerian elements.
+*/
+type LawrenceEphemeris struct{}
+
+/*
+	MeeusEphemeris is the ephemeris of Meeus, i.e., the Sun of ch.25 and the Moon of the truncated ch.47 series of
+	GetLunarEclipticPosition(), with the planets from their Keplerian elements.
+*/
+type MeeusEphemeris struct{}
+
+/*
+	VSOP87Ephemeris is the ephemeris of Meeus with the apparent Sun of GetApparentSolarEclipticPosition(), i.e., from the
+	truncated VSOP87 theory, corrected for nutation and aberration.
+*/
+type VSOP87Ephemeris struct{}
+
+/*
+	getApparentEclipticPosition()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param body - the body of type Body, e.g., BodyMoon
+	@param ec - the geocentric ecliptic coordinate (λ, β, Δ in km) of the body, referred to the mean equinox of date
+	@returns the apparent geocentric ecliptic coordinate of the body, referred to the true equinox of date, i.e., corrected
+	for the nutation in longitude and for the annual aberration, which is neglected for the Moon as it moves with the Earth
+	@see eq.23.2 p.139, ch.25 p.155 & ch.47 p.312 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func getApparentEclipticPosition(datetime time.Time, body Body, ec EclipticCoordinate) EclipticCoordinate {
+	var T = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
+
+	var λ, β = ec.Longitude, ec.Latitude
+
+	switch body {
+	case BodySun:
+		// the radius vector of the Earth (in AU), where the ephemeris gives the distance of the Sun:
+		var R float64 = 1
+
+		if ec.Δ > 0 {
+			R = ec.Δ / ASTRONOMICAL_UNIT_IN_KM
+		}
+
+		λ -= 20.4898 / 3600 / R
+	case BodyMoon:
+	default:
+		// the constant of aberration:
+		var κ = 20.49552 / 3600
+
+		// the geometric longitude of the Sun, and the eccentricity and longitude of the perihelion of the Earth's orbit:
+		var sun = getSolarEclipticPositionMeeus(datetime).Longitude
+
+		var e = 0.016708634 - 0.000042037*T - 0.0000001267*math.Pow(T, 2)
+
+		var π = 102.93735 + 1.71946*T + 0.00046*math.Pow(T, 2)
+
+		λ += (-κ*cosx(sun-ec.Longitude) + e*κ*cosx(π-ec.Longitude)) / cosx(ec.Latitude)
+
+		β -= κ * sinx(ec.Latitude) * (sinx(sun-ec.Longitude) - e*sinx(π-ec.Longitude))
+	}
+
+	λ += GetNutationInLongitudeOfTheEcliptic(GetSolarMeanLongitude(T), GetLunarMeanLongitude(T), GetLunarLongitudeOfTheAscendingNode(T))
+
+	// applies modulo correction to the angle, and ensures always positive:
+	λ = math.Mod(λ, 360)
+
+	// correct for negative angles
+	if λ < 0 {
+		λ += 360
+	}
+
+	return EclipticCoordinate{
+		Longitude: λ,
+		Latitude:  β,
+		Δ:         ec.Δ,
+	}
+}
+
+/*
+	GetEclipticPosition()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param body - the body of type Body, e.g., BodyMoon
+	@returns the apparent geocentric ecliptic coordinate (λ, β, Δ in km) of the body, referred to the true equinox of date
+*/
+func (LawrenceEphemeris) GetEclipticPosition(datetime time.Time, body Body) (EclipticCoordinate, error) {
+	switch body {
+	case BodySun:
+		return getApparentEclipticPosition(datetime, body, GetSolarEclipticPosition(datetime)), nil
+	case BodyMoon:
+		return getApparentEclipticPosition(datetime, body, GetLunarEclipticPositionLawrence(datetime)), nil
+	}
+
+	ec, err := getPlanetEclipticPositionOfDate(datetime, body)
+
+	if err != nil {
+		return ec, err
+	}
+
+	return getApparentEclipticPosition(datetime, body, ec), nil
+}
+
+/*
+	GetEclipticPosition()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param body - the body of type Body, e.g., BodyMoon
+	@returns the apparent geocentric ecliptic coordinate (λ, β, Δ in km) of the body, referred to the true equinox of date
+*/
+func (MeeusEphemeris) GetEclipticPosition(datetime time.Time, body Body) (EclipticCoordinate, error) {
+	switch body {
+	case BodySun:
+		return getApparentEclipticPosition(datetime, body, getSolarEclipticPositionMeeus(datetime)), nil
+	case BodyMoon:
+		return getApparentEclipticPosition(datetime, body, GetLunarEclipticPosition(datetime)), nil
+	}
+
+	ec, err := getPlanetEclipticPositionOfDate(datetime, body)
+
+	if err != nil {
+		return ec, err
+	}
+
+	return getApparentEclipticPosition(datetime, body, ec), nil
+}
+
+/*
+	GetEclipticPosition()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param body - the body of type Body, e.g., BodySun
+	@returns the apparent geocentric ecliptic coordinate (λ, β, Δ in km) of the body, referred to the true equinox of date
+*/
+func (VSOP87Ephemeris) GetEclipticPosition(datetime time.Time, body Body) (EclipticCoordinate, error) {
+	if body == BodySun {
+		return GetApparentSolarEclipticPosition(datetime), nil
+	}
+
+	return MeeusEphemeris{}.GetEclipticPosition(datetime, body)
+}
+
+/*
+	GetEquatorialPosition()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param body - the body of type Body, e.g., BodyMoon
+	@param ephemeris - the ephemeris backend, e.g., MeeusEphemeris{}
+	@returns the apparent geocentric equatorial coordinate { ra, dec } of the body, referred to the true equinox of date (in degrees)
+*/
+func GetEquatorialPosition(datetime time.Time, body Body, ephemeris Ephemeris) (EquatorialCoordinate, error) {
+	ec, err := ephemeris.GetEclipticPosition(datetime, body)
+
+	if err != nil {
+		return EquatorialCoordinate{}, err
+	}
+
+	var eq = ConvertEclipticCoordinateToEquatorial(datetime, ec)
+
+	// correct for negative angles
+	if eq.RightAscension < 0 {
+		eq.RightAscension += 360
+	}
+
+	return eq, nil
+}
+
+/*
+	getSolarEclipticPositionMeeus()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the geometric ecliptic coordinate (λ, β, Δ in km) of the Sun, referred to the mean equinox of date
+	@see ch.25 p.151 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func getSolarEclipticPositionMeeus(datetime time.Time) EclipticCoordinate {
+	var T = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
+
+	// the geometric mean longitude of the Sun (eq.25.2):
+	var L0 = 280.46646 + 36000.76983*T + 0.0003032*math.Pow(T, 2)
+
+	// the mean anomaly of the Sun (eq.25.3):
+	var M = 357.52911 + 35999.05029*T - 0.0001537*math.Pow(T, 2)
+
+	// the eccentricity of the Earth's orbit (eq.25.4):
+	var e = 0.016708634 - 0.000042037*T - 0.0000001267*math.Pow(T, 2)
+
+	// the equation of center of the Sun:
+	var C = (1.914602-0.004817*T-0.000014*math.Pow(T, 2))*sinx(M) + (0.019993-0.000101*T)*sinx(2*M) + 0.000289*sinx(3*M)
+
+	// the radius vector of the Sun (eq.25.5), in AU:
+	var R = 1.000001018 * (1 - e*e) / (1 + e*cosx(M+C))
+
+	// applies modulo correction to the angle, and ensures always positive:
+	var λ = math.Mod(L0+C, 360)
+
+	// correct for negative angles
+	if λ < 0 {
+		λ += 360
+	}
+
+	return EclipticCoordinate{
+		Longitude: λ,
+		Latitude:  0,
+		Δ:         R * ASTRONOMICAL_UNIT_IN_KM,
+	}
+}
+
+/*
+	getPlanetEclipticPositionOfDate()
+
+	N.B. the Keplerian position is referred to J2000, and so is advanced by the general precession in longitude.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param body - the body of type Body, e.g., BodyJupiter
+	@returns the geocentric ecliptic coordinate (λ, β, Δ in km) of the planet, referred to the mean equinox of date
+	@see eq.21.5 p.128 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func getPlanetEclipticPositionOfDate(datetime time.Time, body Body) (EclipticCoordinate, error) {
+	planet, ok := bodyPlanets[body]
+
+	if !ok {
+		return EclipticCoordinate{}, fmt.Errorf("the ephemeris does not cover the body %s", body)
+	}
+
+	var T = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
+
+	var ec = GetPlanetGeocentricEclipticPosition(datetime, planet)
+
+	// the general precession in longitude since J2000 (in degrees):
+	var p = (5029.0966*T + 1.11113*math.Pow(T, 2)) / 3600
+
+	ec.Longitude = math.Mod(ec.Longitude+p, 360)
+
+	// correct for negative angles
+	if ec.Longitude < 0 {
+		ec.Longitude += 360
+	}
+
+	return ec, nil
+}
@@ -0,0 +1,147 @@
+package dusk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBodyString(t *testing.T) {
+	if BodyMoon.String() != "Moon" {
+		t.Errorf("got %s, wanted %s", BodyMoon.String(), "Moon")
+	}
+
+	if Body(99).String() != "Unknown" {
+		t.Errorf("got %s, wanted %s", Body(99).String(), "Unknown")
+	}
+}
+
+func TestLawrenceEphemerisSun(t *testing.T) {
+	got, err := LawrenceEphemeris{}.GetEclipticPosition(datetime, BodySun)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	var T = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
+
+	var Δψ = GetNutationInLongitudeOfTheEcliptic(GetSolarMeanLongitude(T), GetLunarMeanLongitude(T), GetLunarLongitudeOfTheAscendingNode(T))
+
+	// the geometric Sun of Lawrence, corrected for nutation and for the aberration at 1 AU:
+	var want = GetSolarEclipticPosition(datetime).Longitude + Δψ - 20.4898/3600
+
+	if math.Abs(got.Longitude-want) > 0.0000001 || got.Latitude != 0 {
+		t.Errorf("got %v, wanted %f", got, want)
+	}
+}
+
+func TestLawrenceEphemerisMoon(t *testing.T) {
+	got, err := LawrenceEphemeris{}.GetEclipticPosition(datetime, BodyMoon)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	var want = GetLunarEclipticPositionLawrence(datetime)
+
+	// the apparent Moon differs only by the nutation in longitude, of up to ~19″:
+	if math.Abs(got.Longitude-want.Longitude) > 19.0/3600 || got.Longitude == want.Longitude || got.Latitude != want.Latitude {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+}
+
+func TestMeeusEphemerisSun(t *testing.T) {
+	// see ex.25.a p.153 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	var d = ConvertTTToUTC(time.Date(1992, 10, 13, 0, 0, 0, 0, time.UTC))
+
+	got, err := MeeusEphemeris{}.GetEclipticPosition(d, BodySun)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	// the true geometric longitude 199.90988°, corrected for the nutation (+15.908″) and aberration (-20.539″) of ex.25.b:
+	var want = 199.90988 + (15.908-20.539)/3600
+
+	if math.Abs(got.Longitude-want) > 0.0001 {
+		t.Errorf("got %f, wanted %f", got.Longitude, want)
+	}
+
+	if math.Abs(got.Δ/ASTRONOMICAL_UNIT_IN_KM-0.99766) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got.Δ/ASTRONOMICAL_UNIT_IN_KM, 0.99766)
+	}
+}
+
+func TestMeeusEphemerisMoon(t *testing.T) {
+	// see ex.47.a p.313 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	var d = ConvertTTToUTC(time.Date(1992, 4, 12, 0, 0, 0, 0, time.UTC))
+
+	got, err := MeeusEphemeris{}.GetEclipticPosition(d, BodyMoon)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	var want = GetLunarEclipticPosition(d)
+
+	// the apparent longitude is the geometric longitude plus the nutation in longitude, i.e., +0.004610°:
+	if math.Abs(got.Longitude-want.Longitude-0.004610) > 0.0001 || got.Latitude != want.Latitude || got.Δ != want.Δ {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+}
+
+func TestMeeusEphemerisPlanet(t *testing.T) {
+	// see ex.33.a p.225 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell, i.e., the
+	// apparent longitude of Venus of 1992 December 20.0 TD, referred to the true equinox of date:
+	var d = ConvertTTToUTC(time.Date(1992, 12, 20, 0, 0, 0, 0, time.UTC))
+
+	got, err := MeeusEphemeris{}.GetEclipticPosition(d, BodyVenus)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	// the Keplerian elements are accurate to within approximately an arcminute:
+	if math.Abs(got.Longitude-313.07689) > 0.05 {
+		t.Errorf("got %f, wanted %f", got.Longitude, 313.07689)
+	}
+}
+
+func TestGetEquatorialPositionForEphemerides(t *testing.T) {
+	for _, ephemeris := range []Ephemeris{LawrenceEphemeris{}, MeeusEphemeris{}} {
+		got, err := GetEquatorialPosition(datetime, BodySun, ephemeris)
+
+		if err != nil {
+			t.Errorf("got %q", err)
+		}
+
+		var want = GetApparentSolarEquatorialPosition(datetime)
+
+		// the apparent positions of the solar theories agree to within approximately one hundredth of a degree:
+		if math.Abs(got.RightAscension-want.RightAscension) > 0.02 || math.Abs(got.Declination-want.Declination) > 0.02 {
+			t.Errorf("got %v, wanted %v", got, want)
+		}
+	}
+}
+
+func TestGetEquatorialPositionUnknownBody(t *testing.T) {
+	_, err := GetEquatorialPosition(datetime, Body(99), MeeusEphemeris{})
+
+	if err == nil {
+		t.Errorf("expected an error for an unknown body")
+	}
+}
+
+func TestVSOP87EphemerisSun(t *testing.T) {
+	got, err := VSOP87Ephemeris{}.GetEclipticPosition(datetime, BodySun)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	var want = GetApparentSolarEclipticPosition(datetime)
+
+	if got.Longitude != want.Longitude || got.Latitude != want.Latitude || got.Δ != want.Δ {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+}
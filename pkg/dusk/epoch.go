@@ -32,6 +32,139 @@ func GetDatetimeZeroHour(datetime time.Time) time.Time {
 	return time.Date(datetime.Year(), datetime.Month(), datetime.Day(), 0, 0, 0, 0, time.UTC)
 }
 
+/*
+	JulianDate is a two-part Julian date, i.e., a whole number of days and the fraction of the day elapsed since noon,
+	which (unlike a single float64) preserves nanosecond precision.
+*/
+type JulianDate struct {
+	/*
+		Day - the Julian day number, i.e., the whole days since the beginning of the Julian period (with days beginning at noon)
+	*/
+	Day int64 `json:"day"`
+	/*
+		Fraction - the fraction of the day elapsed since noon, between [0, 1)
+	*/
+	Fraction float64 `json:"fraction"`
+}
+
+/*
+	NewJulianDate()
+
+	@param day - the whole (or fractional) number of days
+	@param fraction - the fraction of a day (which may be negative, or greater than one)
+	@returns the normalised two-part Julian date, i.e., with the fraction between [0, 1)
+*/
+func NewJulianDate(day float64, fraction float64) JulianDate {
+	var d = math.Floor(day)
+
+	// carry any fractional part of the day into the fraction:
+	var f = (day - d) + fraction
+
+	var carry = math.Floor(f)
+
+	f -= carry
+
+	// guard against the rounding of a tiny negative fraction up to one:
+	if f >= 1 {
+		f = 0
+		carry++
+	}
+
+	return JulianDate{
+		Day:      int64(d + carry),
+		Fraction: f,
+	}
+}
+
+/*
+	GetPreciseJulianDate()
+
+	@param datetime - the datetime of the observer
+	@returns the two-part Julian date, exact to the nanosecond
+*/
+func GetPreciseJulianDate(datetime time.Time) JulianDate {
+	// seconds elapsed since noon of 31 December 1969 (UTC), i.e., the beginning of Julian day 2440587:
+	var s = datetime.Unix() + 43200
+
+	var days = s / 86400
+
+	// correct the truncated division for datetimes before 1970:
+	if s%86400 < 0 {
+		days--
+	}
+
+	var seconds = s - days*86400
+
+	return JulianDate{
+		Day:      2440587 + days,
+		Fraction: (float64(seconds) + float64(datetime.Nanosecond())/1e9) / 86400,
+	}
+}
+
+/*
+	Float()
+
+	@returns the Julian date as a single float64 (with a precision of approximately 40 microseconds)
+*/
+func (jd JulianDate) Float() float64 {
+	return float64(jd.Day) + jd.Fraction
+}
+
+/*
+	Add()
+
+	@param days - the number of (fractional) days to add
+	@returns the two-part Julian date offset by the number of days
+*/
+func (jd JulianDate) Add(days float64) JulianDate {
+	var d = math.Trunc(days)
+
+	return NewJulianDate(float64(jd.Day)+d, jd.Fraction+(days-d))
+}
+
+/*
+	Sub()
+
+	@param other - the two-part Julian date to subtract
+	@returns the number of (fractional) days between the Julian dates
+*/
+func (jd JulianDate) Sub(other JulianDate) float64 {
+	return float64(jd.Day-other.Day) + (jd.Fraction - other.Fraction)
+}
+
+/*
+	SubFloat()
+
+	@param JD - the Julian date to subtract, e.g., J2000
+	@returns the number of (fractional) days between the Julian dates
+*/
+func (jd JulianDate) SubFloat(JD float64) float64 {
+	var d = math.Floor(JD)
+
+	return float64(jd.Day-int64(d)) + (jd.Fraction - (JD - d))
+}
+
+/*
+	Time()
+
+	@returns the universal time (UTC) for the two-part Julian date, to the nearest nanosecond
+*/
+func (jd JulianDate) Time() time.Time {
+	var seconds = jd.Fraction * 86400
+
+	var s = math.Floor(seconds)
+
+	var ns = int64(math.Round((seconds - s) * 1e9))
+
+	// carry a nanosecond count rounded up to a whole second:
+	if ns >= 1e9 {
+		s++
+		ns -= 1e9
+	}
+
+	return time.Unix((jd.Day-2440587)*86400-43200+int64(s), ns).UTC()
+}
+
 /*
 	GetJulianDate()
 
@@ -39,19 +172,29 @@ func GetDatetimeZeroHour(datetime time.Time) time.Time {
 	@see http://astro.vaporia.com/start/jd.html
 */
 func GetJulianDate(datetime time.Time) float64 {
-	// milliseconds elapsed since 1 January 1970 00:00:00 UTC up until now as an int64:
-	var time int64 = datetime.UTC().UnixNano() / 1e6
-
-	return float64(time)/86400000.0 + J1970
+	return GetPreciseJulianDate(datetime).Float()
 }
 
 /*
 	GetUniversalTime()
 
+	N.B. a single float64 Julian date resolves to approximately 40 microseconds; use GetUniversalTimeFromJulianDate()
+	for a two-part Julian date to round-trip to the nanosecond.
+
 	@returns the universal time (UTC) for a given Julian date
 */
 func GetUniversalTime(JD float64) time.Time {
-	return time.Unix(0, int64((JD-J1970)*86400000.0*1e6)).UTC()
+	return NewJulianDate(JD, 0).Time()
+}
+
+/*
+	GetUniversalTimeFromJulianDate()
+
+	@param JD - the two-part Julian date, e.g., from GetPreciseJulianDate()
+	@returns the universal time (UTC) for the two-part Julian date, to the nearest nanosecond
+*/
+func GetUniversalTimeFromJulianDate(JD JulianDate) time.Time {
+	return JD.Time()
 }
 
 /*
@@ -61,13 +204,16 @@ func GetUniversalTime(JD float64) time.Time {
 	@returns the local sidereal time relative to Greenwhich, UK
 */
 func GetGreenwhichSiderealTime(datetime time.Time) float64 {
-	JD := GetJulianDate(datetime)
+	// sidereal time is a measure of the rotation of the Earth, and so is a function of Universal Time (UT1):
+	datetime = ConvertUTCToUT1(datetime).UTC()
+
+	JD := GetPreciseJulianDate(datetime)
 
-	JD0 := GetJulianDate(time.Date(datetime.Year(), 1, 0, 0, 0, 0, 0, time.UTC))
+	JD0 := GetPreciseJulianDate(time.Date(datetime.Year(), 1, 0, 0, 0, 0, 0, time.UTC))
 
-	days := math.Floor(JD - JD0)
+	days := math.Floor(JD.Sub(JD0))
 
-	var T = (JD0 - 2415020.0) / 36525
+	var T = JD0.SubFloat(2415020.0) / 36525
 
 	var R = 6.6460656 + 2400.051262*T + 0.00002581*math.Pow(T, 2)
 
@@ -81,7 +227,7 @@ func GetGreenwhichSiderealTime(datetime time.Time) float64 {
 
 	var sec = float64(datetime.Second()) / 3600.0
 
-	var ns = float64(datetime.Nanosecond()) / 3600000000.0
+	var ns = float64(datetime.Nanosecond()) / 3600000000000.0
 
 	var UT float64 = hr + min + sec + ns
 
@@ -104,23 +250,14 @@ func GetGreenwhichSiderealTime(datetime time.Time) float64 {
 	@returns returns the local sidereal time, relative to some location's longitude
 */
 func GetLocalSiderealTime(datetime time.Time, longitude float64) float64 {
-	var GST = GetGreenwhichSiderealTime(datetime)
-
-	var d = (GST + longitude/15.0) / 24.0
-
-	d = d - math.Floor(d)
-
-	// correct for negative hour angles (24 hours is equivalent to 360°)
-	if d < 0 {
-		d += 1
-	}
-
-	return 24.0 * d
+	return GetLocalSiderealTimeForModel(datetime, longitude, LawrenceSiderealTime)
 }
 
 /*
 	GetCurrentJulianDayRelativeToJ2000()
 
+	N.B. this is a count of days of Universal Time; for the count of days of Terrestrial Time (TT), see GetFractionalJulianEphemerisDaysSinceStandardEpoch().
+
 	@returns the number of Julian days between J2000 (i.e., 1 January 2000 00:00:00 UTC) and the the datetime, rounded up the the nearest integer
 	@see http://astro.vaporia.com/start/jd.html
 */
@@ -128,11 +265,8 @@ func GetCurrentJulianDayRelativeToJ2000(datetime time.Time) int {
 	// get the Julian date:
 	var JD float64 = GetJulianDate(datetime)
 
-	// correction for the the fractional Julian Day for leap seconds and terrestrial time (TT):
-	var corr float64 = 0.0008
-
 	// calculate the current Julian day:
-	var n float64 = math.Ceil(JD - 2451545.0 - corr)
+	var n float64 = math.Ceil(JD - 2451545.0)
 
 	return int(n)
 }
@@ -145,10 +279,10 @@ func GetCurrentJulianDayRelativeToJ2000(datetime time.Time) int {
 */
 func GetFractionalJulianDaysSinceStandardEpoch(datetime time.Time) float64 {
 	// get the Julian date:
-	var JD float64 = GetJulianDate(datetime)
+	var JD JulianDate = GetPreciseJulianDate(datetime)
 
 	// calculate the current Julian day:
-	var n float64 = JD - 2451545.0
+	var n float64 = JD.SubFloat(2451545.0)
 
 	return n
 }
@@ -161,10 +295,10 @@ func GetFractionalJulianDaysSinceStandardEpoch(datetime time.Time) float64 {
 */
 func GetCurrentJulianCenturyRelativeToJ2000(datetime time.Time) float64 {
 	// get the Julian date:
-	var JD float64 = GetJulianDate(datetime)
+	var JD JulianDate = GetPreciseJulianDate(datetime)
 
 	// calculate the current Julian century as fractions of centuries:
-	var n float64 = (JD - 2451545.0) / 36525
+	var n float64 = JD.SubFloat(2451545.0) / 36525
 
 	return n
 }
@@ -178,13 +312,13 @@ func GetCurrentJulianCenturyRelativeToJ2000(datetime time.Time) float64 {
 */
 func GetCurrentJulianPeriod(datetime time.Time) JulianPeriod {
 	// get the Julian date:
-	var JD float64 = GetJulianDate(datetime)
+	var JD JulianDate = GetPreciseJulianDate(datetime)
 
 	// calculate the current Julian date as fractions of centuries:
-	var T float64 = (JD - 2451545.0) / 36525
+	var T float64 = JD.SubFloat(2451545.0) / 36525
 
 	return JulianPeriod{
-		JD: JD,
+		JD: JD.Float(),
 		T:  T,
 	}
 }
@@ -192,19 +326,19 @@ func GetCurrentJulianPeriod(datetime time.Time) JulianPeriod {
 /*
 	GetMeanGreenwhichSiderealTimeInDegrees()
 
+	N.B. the sidereal time is evaluated at 0h UT of the day of the datetime; for the sidereal time at the instant, see GetSiderealTime().
+
 	@returns the mean sidereal time at Greenwhich for the desired datetime (in degrees)
 	@see eq.12.4 p.88 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann - Bell.
 */
 func GetMeanGreenwhichSiderealTimeInDegrees(datetime time.Time) float64 {
 	var d time.Time = time.Date(datetime.Year(), datetime.Month(), datetime.Day(), 0, 0, 0, 0, time.UTC)
 
-	var julianPeriod JulianPeriod = GetCurrentJulianPeriod(d)
-
-	// get the Julian date:
-	var JD float64 = julianPeriod.JD
+	// get the Julian date, in Universal Time (UT1):
+	var JD float64 = GetJulianDateUT1(d)
 
 	// the number of Julian centuries between J2000 (i.e., 1 January 2000 00:00:00 UTC) and the the datetime:
-	var T float64 = julianPeriod.T
+	var T float64 = (JD - 2451545.0) / 36525
 
 	// applies modulo correction to the angle, and ensures always positive:
 	var θ = math.Mod(280.46061837+(360.98564736629*(JD-2451545.0))+(0.000387933*math.Pow(T, 2))-(math.Pow(T, 3)/38710000), 360)
@@ -220,26 +354,16 @@ func GetMeanGreenwhichSiderealTimeInDegrees(datetime time.Time) float64 {
 /*
 	GetApparentGreenwhichSiderealTimeInDegrees()
 
+	N.B. the sidereal time is evaluated at 0h UT of the day of the datetime; for the sidereal time at the instant, see GetSiderealTime().
+
 	@returns the apparent sidereal time at Greenwhich for the desired datetime (in degrees)
 	@see eq.12.4 p.88 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann - Bell.
 */
 func GetApparentGreenwhichSiderealTimeInDegrees(datetime time.Time) float64 {
 	var θ float64 = GetMeanGreenwhichSiderealTimeInDegrees(datetime)
 
-	var J float64 = GetCurrentJulianCenturyRelativeToJ2000(datetime)
-
-	var L float64 = GetSolarMeanLongitude(J)
-
-	var l float64 = GetLunarMeanLongitude(J)
-
-	var Ω float64 = GetLunarLongitudeOfTheAscendingNode(J)
-
-	var ε float64 = GetMeanObliquityOfTheEcliptic(J) + GetNutationInObliquityOfTheEcliptic(L, l, Ω)
-
-	var Δψ = GetNutationInLongitudeOfTheEcliptic(L, l, Ω)
-
 	// applies a correction for the true vernal equinox:
-	var corr = Δψ * cosx(ε)
+	var corr = GetEquationOfTheEquinoxes(datetime)
 
 	// applies modulo correction to the angle, and ensures always positive:
 	var ϑ = math.Mod(θ+corr, 360)
@@ -40,6 +40,119 @@ func TestGetUniversalTime(t *testing.T) {
 	}
 }
 
+func TestGetPreciseJulianDate(t *testing.T) {
+	// the launch of Sputnik 1, 1957 October 4.81 (see ex.7.a p.61 of Meeus):
+	var datetime time.Time = time.Date(1957, 10, 4, 19, 26, 24, 0, time.UTC)
+
+	var got JulianDate = GetPreciseJulianDate(datetime)
+
+	if got.Day != 2436116 {
+		t.Errorf("got %d, wanted %d", got.Day, 2436116)
+	}
+
+	if math.Abs(got.Fraction-0.31) > 0.000000001 {
+		t.Errorf("got %f, wanted %f", got.Fraction, 0.31)
+	}
+}
+
+func TestGetPreciseJulianDateRoundTrip(t *testing.T) {
+	var datetimes = []time.Time{
+		datetime,
+		time.Date(2021, 5, 14, 23, 59, 59, 999999999, time.UTC),
+		time.Date(1969, 12, 31, 11, 59, 59, 1, time.UTC),
+		time.Date(1582, 10, 15, 12, 0, 0, 123456789, time.UTC),
+		time.Date(2262, 4, 11, 23, 47, 16, 854775807, time.UTC),
+		time.Date(-4712, 1, 1, 12, 0, 0, 1, time.UTC),
+	}
+
+	for _, want := range datetimes {
+		var got = GetPreciseJulianDate(want).Time()
+
+		if !got.Equal(want) {
+			t.Errorf("got %q, wanted %q", got, want)
+		}
+	}
+}
+
+func TestGetPreciseJulianDateNanosecond(t *testing.T) {
+	var a = GetPreciseJulianDate(datetime)
+
+	var b = GetPreciseJulianDate(datetime.Add(time.Nanosecond))
+
+	var got = b.Sub(a) * 86400 * 1e9
+
+	if math.Abs(got-1) > 0.01 {
+		t.Errorf("got %f, wanted %f", got, 1.0)
+	}
+}
+
+func TestNewJulianDate(t *testing.T) {
+	var got JulianDate = NewJulianDate(2459348.25, -0.75)
+
+	if got.Day != 2459347 {
+		t.Errorf("got %d, wanted %d", got.Day, 2459347)
+	}
+
+	if math.Abs(got.Fraction-0.5) > 0.000000001 {
+		t.Errorf("got %f, wanted %f", got.Fraction, 0.5)
+	}
+}
+
+func TestJulianDateAdd(t *testing.T) {
+	var got = GetPreciseJulianDate(datetime).Add(-1.5).Time()
+
+	var want = time.Date(2021, 5, 12, 12, 0, 0, 0, time.UTC)
+
+	if !got.Equal(want) {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+
+	got = GetPreciseJulianDate(datetime).Add(1 / 86400e6).Time()
+
+	want = datetime.Add(time.Microsecond)
+
+	if !got.Equal(want) {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestJulianDateSubFloat(t *testing.T) {
+	var got float64 = GetPreciseJulianDate(datetime).SubFloat(J2000)
+
+	var want float64 = 7803.5
+
+	if got != want {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetUniversalTimeRoundTrip(t *testing.T) {
+	var want time.Time = time.Date(2021, 5, 14, 13, 24, 56, 789000000, time.UTC)
+
+	var got time.Time = GetUniversalTime(GetJulianDate(want))
+
+	// a single float64 Julian date resolves to approximately 40 microseconds:
+	if math.Abs(got.Sub(want).Seconds()) > 0.00005 {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestGetUniversalTimeFromJulianDateRoundTrip(t *testing.T) {
+	var datetimes = []time.Time{
+		time.Date(2021, 5, 14, 13, 24, 56, 789123456, time.UTC),
+		time.Date(1900, 1, 1, 0, 0, 0, 1, time.UTC),
+		time.Date(2100, 12, 31, 23, 59, 59, 999999999, time.UTC),
+	}
+
+	for _, want := range datetimes {
+		var got time.Time = GetUniversalTimeFromJulianDate(GetPreciseJulianDate(want))
+
+		if !got.Equal(want) {
+			t.Errorf("got %q, wanted %q", got, want)
+		}
+	}
+}
+
 func TestGetGreenwhichSiderealTime(t *testing.T) {
 	var got float64 = GetGreenwhichSiderealTime(datetime)
 
@@ -50,6 +163,19 @@ func TestGetGreenwhichSiderealTime(t *testing.T) {
 	}
 }
 
+func TestGetGreenwhichSiderealTimeNanoseconds(t *testing.T) {
+	var datetime time.Time = time.Date(2010, 2, 7, 23, 30, 0, 0, time.UTC)
+
+	// half a second of Universal Time is ~0.5014 seconds of sidereal time:
+	var got = (GetGreenwhichSiderealTime(datetime.Add(500*time.Millisecond)) - GetGreenwhichSiderealTime(datetime)) * 3600
+
+	var want = 0.5 * 1.002737909
+
+	if math.Abs(got-want) > 0.0001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
 func TestGetGreenwhichSiderealTimeLawrence(t *testing.T) {
 	var datetime time.Time = time.Date(2010, 2, 7, 23, 30, 0, 0, time.UTC)
 
@@ -165,9 +291,10 @@ func TestGetApparentGreenwhichSiderealTimeInDegrees(t *testing.T) {
 
 	var got float64 = GetApparentGreenwhichSiderealTimeInDegrees(datetime)
 
-	var want float64 = 197.692600
+	// 13h10m46.1351s, with the nutation of the IAU 1980 theory:
+	var want float64 = 197.692230
 
-	if math.Abs(got-want) > 0.00001 {
+	if math.Abs(got-want) > 0.000005 {
 		t.Errorf("got %f, wanted %f", got, want)
 	}
 }
@@ -181,9 +308,10 @@ func TestGetApparentGreenwhichSiderealTimeInDegreesBonus(t *testing.T) {
 
 	var got float64 = GetApparentGreenwhichSiderealTimeInDegrees(datetime)
 
-	var want float64 = 177.741993
+	// 11h50m58.10s, as given to a hundredth of a second of time:
+	var want float64 = 177.742083
 
-	if math.Abs(got-want) > 0.00001 {
+	if math.Abs(got-want) > 0.00005 {
 		t.Errorf("got %f, wanted %f", got, want)
 	}
 }
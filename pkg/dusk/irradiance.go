@@ -0,0 +1,240 @@
+package dusk
+
+import (
+	"math"
+	"time"
+)
+
+/*
+	the total solar irradiance at the mean Earth–Sun distance of 1 AU (in W/m²)
+*/
+var SOLAR_CONSTANT float64 = 1361
+
+type TrackerAngles struct {
+	/*
+		Rotation - the rotation of the tracker about its axis, right-handed about the axis direction, in degrees
+	*/
+	Rotation float64 `json:"rotation"`
+	/*
+		SurfaceTilt - the tilt of the surface from the horizontal, in degrees
+	*/
+	SurfaceTilt float64 `json:"surfaceTilt"`
+	/*
+		SurfaceAzimuth - the azimuth of the surface normal, eastwards from north, in degrees
+	*/
+	SurfaceAzimuth float64 `json:"surfaceAzimuth"`
+	/*
+		AngleOfIncidence - the angle between the direction of the Sun and the surface normal, in degrees
+	*/
+	AngleOfIncidence float64 `json:"angleOfIncidence"`
+}
+
+type ClearSkyIrradiance struct {
+	/*
+		GlobalHorizontal - the global horizontal irradiance (GHI), in W/m²
+	*/
+	GlobalHorizontal float64 `json:"ghi"`
+	/*
+		DirectNormal - the direct normal irradiance (DNI), in W/m²
+	*/
+	DirectNormal float64 `json:"dni"`
+	/*
+		DiffuseHorizontal - the diffuse horizontal irradiance (DHI), in W/m²
+	*/
+	DiffuseHorizontal float64 `json:"dhi"`
+}
+
+/*
+	convertHorizontalCoordinateToUnitVector()
+
+	@param hz - the horizontal coordinate, with the azimuth measured eastwards from north
+	@returns the unit vector (east, north, up) in the direction of the horizontal coordinate
+*/
+func convertHorizontalCoordinateToUnitVector(hz HorizontalCoordinate) [3]float64 {
+	return [3]float64{
+		cosx(hz.Altitude) * sinx(hz.Azimuth),
+		cosx(hz.Altitude) * cosx(hz.Azimuth),
+		sinx(hz.Altitude),
+	}
+}
+
+/*
+	GetAngleOfIncidence()
+
+	@param sun - the horizontal coordinate of the Sun, e.g., from GetSolarHorizontalPosition()
+	@param tilt - the tilt of the plane from the horizontal (in degrees)
+	@param orientation - the azimuth of the normal of the plane, eastwards from north (in degrees)
+	@returns the angle between the direction of the Sun and the normal of the plane (in degrees), where angles greater
+	than 90° are behind the plane
+*/
+func GetAngleOfIncidence(sun HorizontalCoordinate, tilt float64, orientation float64) float64 {
+	var z = 90 - sun.Altitude
+
+	var cosθ = cosx(z)*cosx(tilt) + sinx(z)*sinx(tilt)*cosx(sun.Azimuth-orientation)
+
+	return acosx(math.Max(-1, math.Min(1, cosθ)))
+}
+
+/*
+	GetSingleAxisTrackerAngles()
+
+	N.B. with backtracking, the tracker rotates away from the Sun to avoid shading the adjacent rows of trackers.
+
+	@param sun - the horizontal coordinate of the Sun, e.g., from GetSolarHorizontalPosition()
+	@param axisTilt - the tilt of the axis of the tracker from the horizontal (in degrees)
+	@param axisAzimuth - the azimuth of the direction of the axis of the tracker, eastwards from north (in degrees)
+	@param maximumAngle - the maximum rotation of the tracker either side of its neutral position (in degrees)
+	@param backtrack - whether the tracker backtracks to avoid row-to-row shading
+	@param groundCoverageRatio - the ratio of the width of the tracker to the spacing of the rows of trackers
+	@returns the rotation of the tracker, and the tilt, azimuth and angle of incidence of its surface, which are NaN if
+	the Sun is not above the horizon
+	@see Marion, W. F. & Dobos, A. P. 2013. Rotation Angle for the Optimum Tracking of One-Axis Trackers. NREL/TP-6A20-58891
+*/
+func GetSingleAxisTrackerAngles(sun HorizontalCoordinate, axisTilt float64, axisAzimuth float64, maximumAngle float64, backtrack bool, groundCoverageRatio float64) TrackerAngles {
+	if sun.Altitude <= 0 {
+		return TrackerAngles{
+			Rotation:         math.NaN(),
+			SurfaceTilt:      math.NaN(),
+			SurfaceAzimuth:   math.NaN(),
+			AngleOfIncidence: math.NaN(),
+		}
+	}
+
+	var s = convertHorizontalCoordinateToUnitVector(sun)
+
+	// the direction of the Sun in the frame of the tracker, where the y axis is along the axis of the tracker:
+	var x = s[0]*cosx(axisAzimuth) - s[1]*sinx(axisAzimuth)
+
+	var z = s[0]*sinx(axisTilt)*sinx(axisAzimuth) + s[1]*sinx(axisTilt)*cosx(axisAzimuth) + s[2]*cosx(axisTilt)
+
+	// the ideal rotation places the Sun in the plane of the surface normal and the axis:
+	var ideal = atan2yx(x, z)
+
+	var θ = ideal
+
+	if backtrack && groundCoverageRatio > 0 {
+		// the ratio of the spacing of the rows to the shadow of a row projected perpendicular to the axis:
+		var r = math.Abs(cosx(ideal) / groundCoverageRatio)
+
+		if r < 1 {
+			θ = ideal - math.Copysign(acosx(r), ideal)
+		}
+	}
+
+	θ = math.Max(-maximumAngle, math.Min(maximumAngle, θ))
+
+	// the surface normal, rotated from the frame of the tracker to the east, north and up frame of the observer:
+	var n = [3]float64{
+		cosx(axisAzimuth)*sinx(θ) + sinx(axisTilt)*sinx(axisAzimuth)*cosx(θ),
+		-sinx(axisAzimuth)*sinx(θ) + sinx(axisTilt)*cosx(axisAzimuth)*cosx(θ),
+		cosx(axisTilt) * cosx(θ),
+	}
+
+	var A = atan2yx(n[0], n[1])
+
+	// correct for negative angles
+	if A < 0 {
+		A += 360
+	}
+
+	var cosθ = n[0]*s[0] + n[1]*s[1] + n[2]*s[2]
+
+	return TrackerAngles{
+		Rotation:         θ,
+		SurfaceTilt:      acosx(math.Max(-1, math.Min(1, n[2]))),
+		SurfaceAzimuth:   A,
+		AngleOfIncidence: acosx(math.Max(-1, math.Min(1, cosθ))),
+	}
+}
+
+/*
+	GetExtraterrestrialIrradiance()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the solar irradiance at the top of the atmosphere, normal to the direction of the Sun (in W/m²)
+*/
+func GetExtraterrestrialIrradiance(datetime time.Time) float64 {
+	return SOLAR_CONSTANT / math.Pow(GetSolarDistance(datetime), 2)
+}
+
+/*
+	getKastenYoungRelativeAirMass()
+
+	@param z - the apparent zenith angle of the Sun (in degrees)
+	@returns the relative optical air mass
+	@see Kasten, F. & Young, A. T. 1989. Revised optical air mass tables and approximation formula. Applied Optics 28:4735.
+*/
+func getKastenYoungRelativeAirMass(z float64) float64 {
+	return 1 / (cosx(z) + 0.50572*math.Pow(96.07995-z, -1.6364))
+}
+
+/*
+	GetClearSkyIrradianceHaurwitz()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@returns the clear-sky global horizontal irradiance (in W/m²), which is zero if the Sun is not above the horizon
+	@see Haurwitz, B. 1945. Insolation in relation to cloudiness and cloud density. Journal of Meteorology 2:154-166.
+*/
+func GetClearSkyIrradianceHaurwitz(datetime time.Time, longitude float64, latitude float64) float64 {
+	var hz = getSolarApparentHorizontalPosition(datetime, longitude, latitude)
+
+	if hz.Altitude <= 0 {
+		return 0
+	}
+
+	var cosz = sinx(hz.Altitude)
+
+	return 1098 * cosz * math.Exp(-0.059/cosz)
+}
+
+/*
+	GetClearSkyIrradianceIneichen()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param elevation - is the elevation (above sea level) in meters of some observer on Earth
+	@param turbidity - the Linke turbidity factor of the atmosphere, e.g., ~3 for a typical clear sky
+	@returns the clear-sky global horizontal, direct normal and diffuse horizontal irradiance (in W/m²), which are zero if
+	the Sun is not above the horizon
+	@see Ineichen, P. & Perez, R. 2002. A new airmass independent formulation for the Linke turbidity coefficient. Solar Energy 73:151-157.
+*/
+func GetClearSkyIrradianceIneichen(datetime time.Time, longitude float64, latitude float64, elevation float64, turbidity float64) ClearSkyIrradiance {
+	var hz = getSolarApparentHorizontalPosition(datetime, longitude, latitude)
+
+	if hz.Altitude <= 0 {
+		return ClearSkyIrradiance{}
+	}
+
+	var z = 90 - hz.Altitude
+
+	var cosz = cosx(z)
+
+	// the absolute air mass, i.e., the relative air mass scaled by the pressure of the standard atmosphere at the elevation:
+	var am = getKastenYoungRelativeAirMass(z) * math.Pow(1-2.25577e-5*elevation, 5.25588)
+
+	var E0 = GetExtraterrestrialIrradiance(datetime)
+
+	var fh1 = math.Exp(-elevation / 8000)
+
+	var fh2 = math.Exp(-elevation / 1250)
+
+	var cg1 = 5.09e-5*elevation + 0.868
+
+	var cg2 = 3.92e-5*elevation + 0.0387
+
+	var ghi = math.Max(0, cg1*E0*cosz*math.Exp(-cg2*am*(fh1+fh2*(turbidity-1))))
+
+	var b = 0.664 + 0.163/fh1
+
+	// the direct normal irradiance is limited so as not to exceed the global irradiance:
+	var dni = math.Min(b*E0*math.Exp(-0.09*am*(turbidity-1)), ghi*math.Max(0, (1-(0.1-0.2*math.Exp(-turbidity))/(0.1+0.882/fh1))/cosz))
+
+	return ClearSkyIrradiance{
+		GlobalHorizontal:  ghi,
+		DirectNormal:      dni,
+		DiffuseHorizontal: ghi - dni*cosz,
+	}
+}
@@ -0,0 +1,141 @@
+package dusk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGetAngleOfIncidence(t *testing.T) {
+	var sun = HorizontalCoordinate{Altitude: 40, Azimuth: 135}
+
+	// a horizontal plane is incident at the zenith angle of the Sun:
+	if got := GetAngleOfIncidence(sun, 0, 180); math.Abs(got-50) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got, 50.0)
+	}
+
+	// a plane facing the Sun is normal to it:
+	if got := GetAngleOfIncidence(sun, 50, 135); math.Abs(got) > 0.0001 {
+		t.Errorf("got %f, wanted %f", got, 0.0)
+	}
+
+	// a vertical plane facing away from the Sun is behind it:
+	if got := GetAngleOfIncidence(sun, 90, 315); math.Abs(got-140) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got, 140.0)
+	}
+}
+
+func TestGetSingleAxisTrackerAngles(t *testing.T) {
+	// a horizontal north-south tracker, with the Sun in the west:
+	var got = GetSingleAxisTrackerAngles(HorizontalCoordinate{Altitude: 30, Azimuth: 270}, 0, 180, 90, false, 0.5)
+
+	if math.Abs(got.Rotation-60) > 0.000001 || math.Abs(got.SurfaceTilt-60) > 0.000001 || math.Abs(got.SurfaceAzimuth-270) > 0.000001 {
+		t.Errorf("got %v, wanted a rotation of %f towards the west", got, 60.0)
+	}
+
+	if math.Abs(got.AngleOfIncidence) > 0.0001 {
+		t.Errorf("got %f, wanted %f", got.AngleOfIncidence, 0.0)
+	}
+
+	// the tracker backtracks at lower altitudes, i.e., 70° - acos(2 cos 70°):
+	got = GetSingleAxisTrackerAngles(HorizontalCoordinate{Altitude: 20, Azimuth: 270}, 0, 180, 90, true, 0.5)
+
+	if math.Abs(got.Rotation-(70-acosx(2*cosx(70)))) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.Rotation, 70-acosx(2*cosx(70)))
+	}
+
+	// the rotation is limited by the maximum angle of the tracker:
+	got = GetSingleAxisTrackerAngles(HorizontalCoordinate{Altitude: 10, Azimuth: 90}, 0, 180, 45, false, 0.5)
+
+	if math.Abs(got.Rotation+45) > 0.000001 || math.Abs(got.SurfaceAzimuth-90) > 0.000001 {
+		t.Errorf("got %v, wanted a rotation of %f towards the east", got, -45.0)
+	}
+
+	// the surface is consistent with the angle of incidence on its tilted and oriented plane:
+	if math.Abs(got.AngleOfIncidence-GetAngleOfIncidence(HorizontalCoordinate{Altitude: 10, Azimuth: 90}, got.SurfaceTilt, got.SurfaceAzimuth)) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.AngleOfIncidence, GetAngleOfIncidence(HorizontalCoordinate{Altitude: 10, Azimuth: 90}, got.SurfaceTilt, got.SurfaceAzimuth))
+	}
+
+	got = GetSingleAxisTrackerAngles(HorizontalCoordinate{Altitude: -10, Azimuth: 90}, 0, 180, 45, false, 0.5)
+
+	if !math.IsNaN(got.Rotation) {
+		t.Errorf("got %f, wanted NaN", got.Rotation)
+	}
+}
+
+func TestGetSingleAxisTrackerAnglesTiltedAxis(t *testing.T) {
+	var sun = HorizontalCoordinate{Altitude: 35, Azimuth: 200}
+
+	var got = GetSingleAxisTrackerAngles(sun, 20, 180, 90, false, 0.5)
+
+	if math.Abs(got.AngleOfIncidence-GetAngleOfIncidence(sun, got.SurfaceTilt, got.SurfaceAzimuth)) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.AngleOfIncidence, GetAngleOfIncidence(sun, got.SurfaceTilt, got.SurfaceAzimuth))
+	}
+
+	// the ideal rotation leaves only the angle of the Sun out of the plane of rotation, i.e., from the axis (which descends towards its azimuth):
+	var s = convertHorizontalCoordinateToUnitVector(sun)
+
+	var axis = [3]float64{cosx(20) * sinx(180), cosx(20) * cosx(180), -sinx(20)}
+
+	var want = asinx(math.Abs(s[0]*axis[0] + s[1]*axis[1] + s[2]*axis[2]))
+
+	if math.Abs(got.AngleOfIncidence-want) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.AngleOfIncidence, want)
+	}
+}
+
+func TestGetExtraterrestrialIrradiance(t *testing.T) {
+	// the Earth is at perihelion on 2021 January 2, at 0.9832570 AU:
+	var got = GetExtraterrestrialIrradiance(time.Date(2021, 1, 2, 13, 51, 0, 0, time.UTC))
+
+	if math.Abs(got-1361/math.Pow(0.9832570, 2)) > 0.1 {
+		t.Errorf("got %f, wanted %f", got, 1361/math.Pow(0.9832570, 2))
+	}
+}
+
+func TestGetClearSkyIrradianceHaurwitz(t *testing.T) {
+	var noon = GetSolarNoonInUTC(time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC), longitude)
+
+	// the Sun is ~1° from the zenith, i.e., 1098 exp(-0.059):
+	if got := GetClearSkyIrradianceHaurwitz(noon, longitude, latitude); math.Abs(got-1035) > 2 {
+		t.Errorf("got %f, wanted %f", got, 1035.0)
+	}
+
+	if got := GetClearSkyIrradianceHaurwitz(noon.Add(12*time.Hour), longitude, latitude); got != 0 {
+		t.Errorf("got %f, wanted %f", got, 0.0)
+	}
+}
+
+func TestGetClearSkyIrradianceIneichen(t *testing.T) {
+	var noon = GetSolarNoonInUTC(time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC), longitude)
+
+	var got = GetClearSkyIrradianceIneichen(noon, longitude, latitude, 0, 3)
+
+	if got.GlobalHorizontal < 950 || got.GlobalHorizontal > 1100 {
+		t.Errorf("got %f, wanted approximately %f", got.GlobalHorizontal, 1030.0)
+	}
+
+	if got.DirectNormal < 800 || got.DirectNormal > 1000 {
+		t.Errorf("got %f, wanted approximately %f", got.DirectNormal, 900.0)
+	}
+
+	// the global irradiance is the sum of the direct and diffuse irradiance on the horizontal:
+	var z = 90 - getSolarApparentHorizontalPosition(noon, longitude, latitude).Altitude
+
+	if math.Abs(got.GlobalHorizontal-(got.DirectNormal*cosx(z)+got.DiffuseHorizontal)) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.GlobalHorizontal, got.DirectNormal*cosx(z)+got.DiffuseHorizontal)
+	}
+
+	// a more turbid atmosphere, and a lower altitude, reduce the direct irradiance:
+	if hazy := GetClearSkyIrradianceIneichen(noon, longitude, latitude, 0, 5); hazy.DirectNormal >= got.DirectNormal {
+		t.Errorf("got %f, wanted less than %f", hazy.DirectNormal, got.DirectNormal)
+	}
+
+	if mountain := GetClearSkyIrradianceIneichen(noon, longitude, latitude, 4205, 3); mountain.DirectNormal <= got.DirectNormal {
+		t.Errorf("got %f, wanted more than %f", mountain.DirectNormal, got.DirectNormal)
+	}
+
+	if night := GetClearSkyIrradianceIneichen(noon.Add(12*time.Hour), longitude, latitude, 0, 3); night != (ClearSkyIrradiance{}) {
+		t.Errorf("got %v, wanted %v", night, ClearSkyIrradiance{})
+	}
+}
@@ -0,0 +1,470 @@
+package dusk
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"time"
+)
+
+/*
+	the speed of light, in km per second
+*/
+var SPEED_OF_LIGHT_IN_KM_PER_SECOND float64 = 299792.458
+
+/*
+	the NAIF integer codes of the bodies of a JPL Development Ephemeris, in order of preference, i.e., the body itself and
+	then the barycenter of its system (which, for the outer planets, is all that the DE files provide)
+
+	@see https://naif.jpl.nasa.gov/pub/naif/toolkit_docs/C/req/naif_ids.html
+*/
+var jplBodyCodes = map[Body][]int{
+	BodySun:     {10},
+	BodyMoon:    {301},
+	BodyMercury: {199, 1},
+	BodyVenus:   {299, 2},
+	BodyMars:    {499, 4},
+	BodyJupiter: {599, 5},
+	BodySaturn:  {699, 6},
+	BodyUranus:  {799, 7},
+	BodyNeptune: {899, 8},
+}
+
+/*
+	the NAIF integer codes of the Solar System barycenter and the Earth
+*/
+const (
+	jplSolarSystemBarycenter = 0
+	jplEarth                 = 399
+)
+
+type jplSegment struct {
+	target int
+	center int
+	kind   int
+	// the coverage of the segment, in TDB seconds past J2000:
+	start float64
+	end   float64
+	// the (1-based) address of the first double precision number of the segment:
+	address int64
+	// the directory of the segment, i.e., the initial epoch and length of the records (in TDB seconds past J2000):
+	init   float64
+	intlen float64
+	rsize  int64
+	n      int64
+}
+
+/*
+	JPLEphemeris is a JPL Development Ephemeris, e.g., DE440 or DE421, read from a binary SPK (.bsp) file.
+*/
+type JPLEphemeris struct {
+	r        io.ReaderAt
+	closer   io.Closer
+	order    binary.ByteOrder
+	segments []jplSegment
+}
+
+/*
+	LoadJPLEphemeris()
+
+	N.B. the file is read on demand, rather than loaded into memory, and so must be closed with Close().
+
+	@param path - the path to a JPL Development Ephemeris SPK file on local disk, e.g., de440.bsp or de421.bsp
+	@returns the JPL ephemeris, or an error.
+*/
+func LoadJPLEphemeris(path string) (*JPLEphemeris, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := ReadJPLEphemeris(f)
+
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	e.closer = f
+
+	return e, nil
+}
+
+/*
+	ReadJPLEphemeris()
+
+	@param r - the reader of the binary SPK file, in the NAIF double precision array file (DAF) format
+	@returns the JPL ephemeris, or an error.
+	@see https://naif.jpl.nasa.gov/pub/naif/toolkit_docs/C/req/daf.html
+	@see https://naif.jpl.nasa.gov/pub/naif/toolkit_docs/C/req/spk.html
+*/
+func ReadJPLEphemeris(r io.ReaderAt) (*JPLEphemeris, error) {
+	var record = make([]byte, 1024)
+
+	if _, err := r.ReadAt(record, 0); err != nil {
+		return nil, fmt.Errorf("invalid SPK file record: %w", err)
+	}
+
+	if !strings.HasPrefix(string(record[0:8]), "DAF/SPK") && !strings.HasPrefix(string(record[0:8]), "NAIF/DAF") {
+		return nil, fmt.Errorf("invalid SPK file record: unexpected identification word %q", strings.TrimSpace(string(record[0:8])))
+	}
+
+	var e = &JPLEphemeris{
+		r:        r,
+		segments: []jplSegment{},
+	}
+
+	switch string(record[88:96]) {
+	case "LTL-IEEE":
+		e.order = binary.LittleEndian
+	case "BIG-IEEE":
+		e.order = binary.BigEndian
+	default:
+		// files that predate the binary format identification word are detected by the number of double precision components:
+		e.order = binary.LittleEndian
+
+		if binary.LittleEndian.Uint32(record[8:12]) != 2 {
+			e.order = binary.BigEndian
+		}
+	}
+
+	var ND = e.int(record[8:12])
+
+	var NI = e.int(record[12:16])
+
+	if ND != 2 || NI != 6 {
+		return nil, fmt.Errorf("invalid SPK file record: expected ND = 2 and NI = 6, got ND = %d and NI = %d", ND, NI)
+	}
+
+	// the size of each summary, in double precision numbers:
+	var SS = ND + (NI+1)/2
+
+	var next = int64(e.int(record[76:80]))
+
+	for next != 0 {
+		if _, err := r.ReadAt(record, (next-1)*1024); err != nil {
+			return nil, fmt.Errorf("invalid SPK summary record %d: %w", next, err)
+		}
+
+		var nsum = int(e.float(record[16:24]))
+
+		for i := 0; i < nsum; i++ {
+			var summary = record[24+i*SS*8 : 24+(i+1)*SS*8]
+
+			var segment = jplSegment{
+				start:   e.float(summary[0:8]),
+				end:     e.float(summary[8:16]),
+				target:  e.int(summary[16:20]),
+				center:  e.int(summary[20:24]),
+				kind:    e.int(summary[28:32]),
+				address: int64(e.int(summary[32:36])),
+			}
+
+			// only the Chebyshev segments, i.e., of type 2 (position) and type 3 (position and velocity) are supported:
+			if segment.kind != 2 && segment.kind != 3 {
+				continue
+			}
+
+			// the directory is given by the last four double precision numbers of the segment:
+			var directory = make([]byte, 32)
+
+			if _, err := r.ReadAt(directory, (int64(e.int(summary[36:40]))-4)*8); err != nil {
+				return nil, fmt.Errorf("invalid SPK segment directory for body %d: %w", segment.target, err)
+			}
+
+			segment.init = e.float(directory[0:8])
+			segment.intlen = e.float(directory[8:16])
+			segment.rsize = int64(e.float(directory[16:24]))
+			segment.n = int64(e.float(directory[24:32]))
+
+			if segment.intlen <= 0 || segment.rsize < 2 || segment.n < 1 {
+				return nil, fmt.Errorf("invalid SPK segment directory for body %d", segment.target)
+			}
+
+			e.segments = append(e.segments, segment)
+		}
+
+		next = int64(e.float(record[0:8]))
+	}
+
+	return e, nil
+}
+
+/*
+	Close()
+
+	@returns an error if the underlying file (as opened by LoadJPLEphemeris()) cannot be closed.
+*/
+func (e *JPLEphemeris) Close() error {
+	if e.closer == nil {
+		return nil
+	}
+
+	return e.closer.Close()
+}
+
+/*
+	Range()
+
+	@returns the first and last datetime (in UTC) covered by every body of the ephemeris
+*/
+func (e *JPLEphemeris) Range() (time.Time, time.Time) {
+	if len(e.segments) == 0 {
+		return time.Time{}, time.Time{}
+	}
+
+	var start, end = math.Inf(-1), math.Inf(1)
+
+	for _, segment := range e.segments {
+		start = math.Max(start, segment.start)
+		end = math.Min(end, segment.end)
+	}
+
+	// N.B. TDB differs from TT by at most ~1.7 milliseconds:
+	return ConvertTTToUTC(GetUniversalTime(J2000 + start/86400)), ConvertTTToUTC(GetUniversalTime(J2000 + end/86400))
+}
+
+/*
+	float()
+
+	@param b - the eight bytes of a double precision number
+	@returns the double precision number, in the byte order of the file
+*/
+func (e *JPLEphemeris) float(b []byte) float64 {
+	return math.Float64frombits(e.order.Uint64(b))
+}
+
+/*
+	int()
+
+	@param b - the four bytes of an integer
+	@returns the integer, in the byte order of the file
+*/
+func (e *JPLEphemeris) int(b []byte) int {
+	return int(int32(e.order.Uint32(b)))
+}
+
+/*
+	getSegment()
+
+	@param target - the NAIF integer code of the body
+	@param ET - the ephemeris time, in TDB seconds past J2000
+	@returns the segment of the body covering the ephemeris time, or false if there is none
+*/
+func (e *JPLEphemeris) getSegment(target int, ET float64) (jplSegment, bool) {
+	// later segments take precedence over earlier ones, as per the SPK specification:
+	for i := len(e.segments) - 1; i >= 0; i-- {
+		var segment = e.segments[i]
+
+		if segment.target == target && ET >= segment.start && ET <= segment.end {
+			return segment, true
+		}
+	}
+
+	return jplSegment{}, false
+}
+
+/*
+	getRelativePosition()
+
+	@param segment - the Chebyshev segment of the body
+	@param ET - the ephemeris time, in TDB seconds past J2000
+	@returns the position of the body relative to the center of the segment (in km)
+	@see https://naif.jpl.nasa.gov/pub/naif/toolkit_docs/FORTRAN/spicelib/spkr02.html
+*/
+func (e *JPLEphemeris) getRelativePosition(segment jplSegment, ET float64) ([3]float64, error) {
+	var position = [3]float64{}
+
+	var k = int64(math.Floor((ET - segment.init) / segment.intlen))
+
+	// the final epoch of the segment belongs to the last record:
+	if k >= segment.n {
+		k = segment.n - 1
+	}
+
+	if k < 0 {
+		k = 0
+	}
+
+	var record = make([]byte, segment.rsize*8)
+
+	if _, err := e.r.ReadAt(record, (segment.address-1+k*segment.rsize)*8); err != nil {
+		return position, fmt.Errorf("invalid SPK record %d for body %d: %w", k, segment.target, err)
+	}
+
+	var mid = e.float(record[0:8])
+
+	var radius = e.float(record[8:16])
+
+	// the number of components per record, i.e., the position (and for type 3, the velocity):
+	var components int64 = 3
+
+	if segment.kind == 3 {
+		components = 6
+	}
+
+	var degree = (segment.rsize - 2) / components
+
+	// the normalised time within the record, between [-1, 1]:
+	var s = (ET - mid) / radius
+
+	for c := int64(0); c < 3; c++ {
+		var T0, T1 = 1.0, s
+
+		var sum float64 = 0
+
+		for j := int64(0); j < degree; j++ {
+			var coefficient = e.float(record[(2+c*degree+j)*8 : (3+c*degree+j)*8])
+
+			switch j {
+			case 0:
+				sum += coefficient * T0
+			case 1:
+				sum += coefficient * T1
+			default:
+				T0, T1 = T1, 2*s*T1-T0
+				sum += coefficient * T1
+			}
+		}
+
+		position[c] = sum
+	}
+
+	return position, nil
+}
+
+/*
+	getBarycentricPosition()
+
+	@param target - the NAIF integer code of the body
+	@param ET - the ephemeris time, in TDB seconds past J2000
+	@returns the position of the body relative to the Solar System barycenter, in the ICRF (in km), or an error
+*/
+func (e *JPLEphemeris) getBarycentricPosition(target int, ET float64) ([3]float64, error) {
+	var position = [3]float64{}
+
+	// chain the segments from the body to the Solar System barycenter, e.g., Moon → Earth-Moon barycenter → barycenter:
+	for target != jplSolarSystemBarycenter {
+		segment, ok := e.getSegment(target, ET)
+
+		if !ok {
+			return position, fmt.Errorf("the JPL ephemeris does not cover the body %d at %f seconds past J2000", target, ET)
+		}
+
+		p, err := e.getRelativePosition(segment, ET)
+
+		if err != nil {
+			return position, err
+		}
+
+		for i := range position {
+			position[i] += p[i]
+		}
+
+		target = segment.center
+	}
+
+	return position, nil
+}
+
+/*
+	GetGeocentricPosition()
+
+	N.B. the position is corrected for light-time, i.e., the body is observed where it was when its light left it.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param target - the NAIF integer code of the body, e.g., 301 for the Moon
+	@returns the geocentric rectangular position of the body, in the ICRF (in km), or an error
+*/
+func (e *JPLEphemeris) GetGeocentricPosition(datetime time.Time, target int) ([3]float64, error) {
+	// the ephemeris time, in TDB seconds past J2000:
+	var ET = GetPreciseJulianDate(ConvertUTCToTDB(datetime)).SubFloat(J2000) * 86400
+
+	earth, err := e.getBarycentricPosition(jplEarth, ET)
+
+	if err != nil {
+		return [3]float64{}, err
+	}
+
+	var position = [3]float64{}
+
+	var τ float64 = 0
+
+	for i := 0; i < 3; i++ {
+		p, err := e.getBarycentricPosition(target, ET-τ)
+
+		if err != nil {
+			return position, err
+		}
+
+		for j := range position {
+			position[j] = p[j] - earth[j]
+		}
+
+		τ = math.Sqrt(position[0]*position[0]+position[1]*position[1]+position[2]*position[2]) / SPEED_OF_LIGHT_IN_KM_PER_SECOND
+	}
+
+	return position, nil
+}
+
+/*
+	GetEclipticPosition()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param body - the body of type Body, e.g., BodyMoon
+	@returns the apparent geocentric ecliptic coordinate (λ, β, Δ in km) of the body, referred to the true equinox of date,
+	or an error if the ephemeris does not cover the body or the datetime.
+*/
+func (e *JPLEphemeris) GetEclipticPosition(datetime time.Time, body Body) (EclipticCoordinate, error) {
+	codes, ok := jplBodyCodes[body]
+
+	if !ok {
+		return EclipticCoordinate{}, fmt.Errorf("the JPL ephemeris does not cover the body %s", body)
+	}
+
+	var ET = GetPreciseJulianDate(ConvertUTCToTDB(datetime)).SubFloat(J2000) * 86400
+
+	// prefer the body itself, and otherwise the barycenter of its system:
+	var target = codes[len(codes)-1]
+
+	for _, code := range codes {
+		if _, ok := e.getSegment(code, ET); ok {
+			target = code
+			break
+		}
+	}
+
+	position, err := e.GetGeocentricPosition(datetime, target)
+
+	if err != nil {
+		return EclipticCoordinate{}, err
+	}
+
+	var x, y, z = position[0], position[1], position[2]
+
+	var r = math.Sqrt(x*x + y*y + z*z)
+
+	var α = atan2yx(y, x)
+
+	// correct for negative angles
+	if α < 0 {
+		α += 360
+	}
+
+	var eq = ConvertJ2000EquatorialCoordinateToEpochOfDate(datetime, EquatorialCoordinate{
+		RightAscension: α,
+		Declination:    asinx(z / r),
+	})
+
+	// the equator of date is the mean equator, and so the ecliptic is found by the mean obliquity, without nutation:
+	var ec = convertEquatorialCoordinateToEclipticForObliquity(eq, GetMeanObliquityOfTheEcliptic(GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)))
+
+	ec.Δ = r
+
+	// the light-time is already allowed for, and so the astrometric position is corrected for nutation and aberration:
+	return getApparentEclipticPosition(datetime, body, ec), nil
+}
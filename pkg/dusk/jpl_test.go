@@ -0,0 +1,256 @@
+package dusk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type jplTestSegment struct {
+	target, center int
+	// the Chebyshev coefficients of x, y and z, for each of the two records of the segment:
+	coefficients [2][3][]float64
+}
+
+/*
+	newJPLTestFile()
+
+	@returns a little-endian SPK file of type 2 segments, each of two records covering ±1e9 TDB seconds about J2000
+*/
+func newJPLTestFile(segments []jplTestSegment) []byte {
+	var file = make([]byte, 3*1024)
+
+	copy(file[0:8], "DAF/SPK ")
+	binary.LittleEndian.PutUint32(file[8:12], 2)
+	binary.LittleEndian.PutUint32(file[12:16], 6)
+	binary.LittleEndian.PutUint32(file[76:80], 2)
+	binary.LittleEndian.PutUint32(file[80:84], 2)
+	copy(file[88:96], "LTL-IEEE")
+
+	var summaries = file[1024:2048]
+
+	binary.LittleEndian.PutUint64(summaries[16:24], math.Float64bits(float64(len(segments))))
+
+	var words = []float64{}
+
+	for i, segment := range segments {
+		var degree = len(segment.coefficients[0][0])
+
+		var address = 3*128 + len(words) + 1
+
+		for k := 0; k < 2; k++ {
+			words = append(words, -5e8+float64(k)*1e9, 5e8)
+
+			for c := 0; c < 3; c++ {
+				words = append(words, segment.coefficients[k][c]...)
+			}
+		}
+
+		words = append(words, -1e9, 1e9, float64(2+3*degree), 2)
+
+		var summary = summaries[24+i*40 : 24+(i+1)*40]
+
+		binary.LittleEndian.PutUint64(summary[0:8], math.Float64bits(-1e9))
+		binary.LittleEndian.PutUint64(summary[8:16], math.Float64bits(1e9))
+
+		for j, v := range []int{segment.target, segment.center, 1, 2, address, 3*128 + len(words)} {
+			binary.LittleEndian.PutUint32(summary[16+j*4:20+j*4], uint32(int32(v)))
+		}
+	}
+
+	var data = make([]byte, len(words)*8)
+
+	for i, w := range words {
+		binary.LittleEndian.PutUint64(data[i*8:(i+1)*8], math.Float64bits(w))
+	}
+
+	return append(file, data...)
+}
+
+var jplTestSegments = []jplTestSegment{
+	// the Sun, fixed at the Solar System barycenter:
+	{10, 0, [2][3][]float64{{{0, 0, 0}, {0, 0, 0}, {0, 0, 0}}, {{0, 0, 0}, {0, 0, 0}, {0, 0, 0}}}},
+	// the Earth-Moon barycenter, fixed at 1 AU along -x:
+	{3, 0, [2][3][]float64{{{-ASTRONOMICAL_UNIT_IN_KM, 0, 0}, {0, 0, 0}, {0, 0, 0}}, {{-ASTRONOMICAL_UNIT_IN_KM, 0, 0}, {0, 0, 0}, {0, 0, 0}}}},
+	// the Earth, fixed at the Earth-Moon barycenter:
+	{399, 3, [2][3][]float64{{{0, 0, 0}, {0, 0, 0}, {0, 0, 0}}, {{0, 0, 0}, {0, 0, 0}, {0, 0, 0}}}},
+	// the Moon, with polynomial motion differing between the records:
+	{301, 3, [2][3][]float64{{{1000, 200, 30}, {384400, 0, 0}, {0, 0, 0}}, {{-1000, 0, 0}, {0, 0, 0}, {384400, 0, 0}}}},
+}
+
+func TestReadJPLEphemeris(t *testing.T) {
+	e, err := ReadJPLEphemeris(bytes.NewReader(newJPLTestFile(jplTestSegments)))
+
+	if err != nil {
+		t.Fatalf("got %q", err)
+	}
+
+	if len(e.segments) != 4 {
+		t.Errorf("got %d, wanted %d", len(e.segments), 4)
+	}
+
+	var start, end = e.Range()
+
+	if start.Year() != 1968 || end.Year() != 2031 {
+		t.Errorf("got [%v, %v], wanted [1968, 2031]", start, end)
+	}
+}
+
+func TestReadJPLEphemerisInvalid(t *testing.T) {
+	_, err := ReadJPLEphemeris(bytes.NewReader(make([]byte, 1024)))
+
+	if err == nil {
+		t.Errorf("expected an error for an invalid SPK file")
+	}
+}
+
+func TestJPLEphemerisChebyshev(t *testing.T) {
+	e, err := ReadJPLEphemeris(bytes.NewReader(newJPLTestFile(jplTestSegments)))
+
+	if err != nil {
+		t.Fatalf("got %q", err)
+	}
+
+	segment, ok := e.getSegment(301, -2.5e8)
+
+	if !ok {
+		t.Fatalf("expected a segment for the Moon")
+	}
+
+	// at s = -0.5 within the first record, x = 1000 + 200s + 30(2s² - 1):
+	got, err := e.getRelativePosition(segment, -7.5e8)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	var s = -0.5
+
+	if math.Abs(got[0]-(1000+200*s+30*(2*s*s-1))) > 1e-9 || math.Abs(got[1]-384400) > 1e-9 {
+		t.Errorf("got %v, wanted [%f, %f, 0]", got, 1000+200*s+30*(2*s*s-1), 384400.0)
+	}
+
+	// the second record:
+	got, err = e.getRelativePosition(segment, 2.5e8)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if got[0] != -1000 || got[2] != 384400 {
+		t.Errorf("got %v, wanted [-1000, 0, 384400]", got)
+	}
+}
+
+func TestJPLEphemerisGetEclipticPositionSun(t *testing.T) {
+	e, err := ReadJPLEphemeris(bytes.NewReader(newJPLTestFile(jplTestSegments)))
+
+	if err != nil {
+		t.Fatalf("got %q", err)
+	}
+
+	// the Sun lies at the vernal equinox of J2000, i.e., along +x of the ICRF:
+	got, err := e.GetEclipticPosition(ConvertTTToUTC(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)), BodySun)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	// the frame bias, the nutation and the aberration (-20.5″) displace the apparent longitude by under ~40″:
+	if math.Min(got.Longitude, 360-got.Longitude)*3600 > 40 || math.Abs(got.Latitude)*3600 > 1 {
+		t.Errorf("got %v, wanted the vernal equinox", got)
+	}
+
+	if math.Abs(got.Δ-ASTRONOMICAL_UNIT_IN_KM) > 1e-6 {
+		t.Errorf("got %f, wanted %f", got.Δ, ASTRONOMICAL_UNIT_IN_KM)
+	}
+}
+
+func TestJPLEphemerisGetEclipticPositionMeeus(t *testing.T) {
+	// the geocentric position of the Sun on 1992 October 13.0 TD, referred to the FK5 J2000 equator (in AU), as given in
+	// ex.26.a p.163 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	var X, Y, Z = -0.93739590, -0.31316793, -0.13577924
+
+	var fixed = func(x, y, z float64) [2][3][]float64 {
+		return [2][3][]float64{{{x}, {y}, {z}}, {{x}, {y}, {z}}}
+	}
+
+	var segments = []jplTestSegment{
+		// the Sun, fixed at the Solar System barycenter (so that the light-time is immaterial):
+		{10, 0, fixed(0, 0, 0)},
+		// the Earth, fixed opposite the Sun:
+		{399, 0, fixed(-X*ASTRONOMICAL_UNIT_IN_KM, -Y*ASTRONOMICAL_UNIT_IN_KM, -Z*ASTRONOMICAL_UNIT_IN_KM)},
+	}
+
+	e, err := ReadJPLEphemeris(bytes.NewReader(newJPLTestFile(segments)))
+
+	if err != nil {
+		t.Fatalf("got %q", err)
+	}
+
+	got, err := e.GetEclipticPosition(ConvertTTToUTC(time.Date(1992, 10, 13, 0, 0, 0, 0, time.UTC)), BodySun)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	// the apparent longitude 199°54'21.818" and latitude +0.62″ in the FK5 system, of ex.25.b p.156 of Meeus, where the
+	// abridged nutation in longitude is accurate to ~0.5″:
+	if math.Abs(got.Longitude-199.906060)*3600 > 0.5 {
+		t.Errorf("got %f, wanted %f", got.Longitude, 199.906060)
+	}
+
+	// the true obliquity would displace the latitude by Δε sin λ, i.e., by ~0.11″ on the date and by up to ~9″ in general:
+	if math.Abs(got.Latitude*3600-0.62) > 0.05 {
+		t.Errorf("got %f, wanted %f", got.Latitude*3600, 0.62)
+	}
+}
+
+func TestJPLEphemerisGetEclipticPositionUncovered(t *testing.T) {
+	e, err := ReadJPLEphemeris(bytes.NewReader(newJPLTestFile(jplTestSegments)))
+
+	if err != nil {
+		t.Fatalf("got %q", err)
+	}
+
+	if _, err := e.GetEclipticPosition(datetime, BodyJupiter); err == nil {
+		t.Errorf("expected an error for a body outside the ephemeris")
+	}
+
+	if _, err := e.GetEclipticPosition(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), BodySun); err == nil {
+		t.Errorf("expected an error for a datetime outside the ephemeris")
+	}
+}
+
+func TestLoadJPLEphemeris(t *testing.T) {
+	var path = filepath.Join(t.TempDir(), "test.bsp")
+
+	if err := os.WriteFile(path, newJPLTestFile(jplTestSegments), 0o644); err != nil {
+		t.Fatalf("got %q", err)
+	}
+
+	e, err := LoadJPLEphemeris(path)
+
+	if err != nil {
+		t.Fatalf("got %q", err)
+	}
+
+	defer e.Close()
+
+	// the JPL ephemeris is a backend for rise, set and phase routines:
+	var ephemeris Ephemeris = e
+
+	got, err := GetEquatorialPosition(datetime, BodyMoon, ephemeris)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if got.Declination < -90 || got.Declination > 90 {
+		t.Errorf("got %f, wanted a declination between [-90°, 90°]", got.Declination)
+	}
+}
@@ -36,7 +36,7 @@ func GetObliquityOfTheEclipticLawrence(J float64) float64 {
 */
 func GetLunarMeanAnomalyLawrence(datetime time.Time) float64 {
 	// the number of days since the standard epoch J2000:
-	var De = GetFractionalJulianDaysSinceStandardEpoch(datetime)
+	var De = GetFractionalJulianEphemerisDaysSinceStandardEpoch(datetime)
 
 	var λ = GetLunarMeanEclipticLongitude(datetime)
 
@@ -99,7 +99,7 @@ func GetLunarEclipticPositionLawrence(datetime time.Time) EclipticCoordinate {
   @returns the equatorial coodinate (λ - geocentric longitude, β - geocentric latidude) of the Moon.
 */
 func GetLunarEquatorialPositionLawrence(datetime time.Time) EquatorialCoordinate {
-	var J = GetCurrentJulianCenturyRelativeToJ2000(datetime)
+	var J = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
 
 	var ε float64 = GetObliquityOfTheEclipticLawrence(J)
 
@@ -129,7 +129,7 @@ func GetLunarEquatorialPositionLawrence(datetime time.Time) EquatorialCoordinate
 */
 func GetSolarMeanAnomalyLawrence(datetime time.Time) float64 {
 	// the number of days since the standard epoch J2000:
-	var De = GetFractionalJulianDaysSinceStandardEpoch(datetime)
+	var De = GetFractionalJulianEphemerisDaysSinceStandardEpoch(datetime)
 
 	// the Sun's ecliptic longitude at the epoch J2000 (given by the The Astronomical Almanac, 2000):
 	var εg = 280.466069
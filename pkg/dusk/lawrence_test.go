@@ -22,8 +22,8 @@ func TestGetObliquityOfTheEclipticLawrence(t *testing.T) {
 }
 
 func TestGetLunarMeanAnomalyLawrence(t *testing.T) {
-	// Date of observation:
-	var datetime time.Time = time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC)
+	// Date of observation (as a reading of Terrestrial Time, TT):
+	var datetime time.Time = ConvertTTToUTC(time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC))
 
 	var got = GetLunarMeanAnomalyLawrence(datetime)
 
@@ -162,8 +162,8 @@ func TestGetLunarEquatorialPositionLawrenceDeclination(t *testing.T) {
 }
 
 func TestGetSolarMeanAnomalyLawrence(t *testing.T) {
-	// Date of observation:
-	var datetime time.Time = time.Date(2015, 2, 5, 17, 0, 0, 0, time.UTC)
+	// Date of observation (as a reading of Terrestrial Time, TT):
+	var datetime time.Time = ConvertTTToUTC(time.Date(2015, 2, 5, 17, 0, 0, 0, time.UTC))
 
 	var got = GetSolarMeanAnomalyLawrence(datetime)
 
@@ -175,8 +175,8 @@ func TestGetSolarMeanAnomalyLawrence(t *testing.T) {
 }
 
 func TestGetSolarMeanAnomalyLawrenceAlt(t *testing.T) {
-	// Date of observation:
-	var datetime time.Time = time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC)
+	// Date of observation (as a reading of Terrestrial Time, TT):
+	var datetime time.Time = ConvertTTToUTC(time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC))
 
 	var got = GetSolarMeanAnomalyLawrence(datetime)
 
@@ -188,8 +188,8 @@ func TestGetSolarMeanAnomalyLawrenceAlt(t *testing.T) {
 }
 
 func TestGetSolarEquationOfCenterLawrence(t *testing.T) {
-	// Date of observation:
-	var datetime time.Time = time.Date(2015, 2, 5, 17, 0, 0, 0, time.UTC)
+	// Date of observation (as a reading of Terrestrial Time, TT):
+	var datetime time.Time = ConvertTTToUTC(time.Date(2015, 2, 5, 17, 0, 0, 0, time.UTC))
 
 	var M = GetSolarMeanAnomalyLawrence(datetime)
 
@@ -203,8 +203,8 @@ func TestGetSolarEquationOfCenterLawrence(t *testing.T) {
 }
 
 func TestGetSolarEclipticLongitudeLawrence(t *testing.T) {
-	// Date of observation:
-	var datetime time.Time = time.Date(2015, 2, 5, 17, 0, 0, 0, time.UTC)
+	// Date of observation (as a reading of Terrestrial Time, TT):
+	var datetime time.Time = ConvertTTToUTC(time.Date(2015, 2, 5, 17, 0, 0, 0, time.UTC))
 
 	var M = GetSolarMeanAnomalyLawrence(datetime)
 
@@ -220,8 +220,8 @@ func TestGetSolarEclipticLongitudeLawrence(t *testing.T) {
 }
 
 func TestGetSolarEclipticLongitudeLawrenceAlt(t *testing.T) {
-	// Date of observation:
-	var datetime time.Time = time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC)
+	// Date of observation (as a reading of Terrestrial Time, TT):
+	var datetime time.Time = ConvertTTToUTC(time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC))
 
 	var M = GetSolarMeanAnomalyLawrence(datetime)
 
@@ -3,8 +3,6 @@ package dusk
 import (
 	"math"
 	"time"
-
-	tzm "github.com/zsefvlol/timezonemapper"
 )
 
 type Moon struct {
@@ -49,7 +47,7 @@ func GetLunarMeanLongitude(J float64) float64 {
 */
 func GetLunarMeanEclipticLongitude(datetime time.Time) float64 {
 	// the number of days since the standard epoch J2000:
-	var De = GetFractionalJulianDaysSinceStandardEpoch(datetime)
+	var De = GetFractionalJulianEphemerisDaysSinceStandardEpoch(datetime)
 
 	// the Moon's ecliptic longitude at tge epoch J2OOO:
 	var λ0 = 218.316433
@@ -104,7 +102,7 @@ func GetLunarTrueEclipticLongitude(datetime time.Time) float64 {
 */
 func GetLunarMeanEclipticLongitudeOfTheAscendingNode(datetime time.Time) float64 {
 	// the number of days since the standard epoch J2000:
-	var De = GetFractionalJulianDaysSinceStandardEpoch(datetime)
+	var De = GetFractionalJulianEphemerisDaysSinceStandardEpoch(datetime)
 
 	// the Moon's ecliptic longitude of the ascending node at the epoch J2000:
 	var Ω0 = 125.044522
@@ -354,7 +352,7 @@ func GetLunarMeanAnomalyCorrection(M float64, Msol float64, Ae float64, Eν floa
   @returns the Lunar equatorial position (right ascension & declination) in degrees:
 */
 func GetLunarEquatorialPosition(datetime time.Time) EquatorialCoordinate {
-	var J float64 = GetCurrentJulianCenturyRelativeToJ2000(datetime)
+	var J float64 = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
 
 	var M float64 = GetLunarMeanAnomaly(J)
 
@@ -389,7 +387,7 @@ func GetLunarEquatorialPosition(datetime time.Time) EquatorialCoordinate {
 	@returns the geocentric ecliptic coodinate (λ - geocentric longitude, β - geocentric latidude and Δ distance between centers of the Earth and Moon, in km) of the Moon.
 */
 func GetLunarEclipticPosition(datetime time.Time) EclipticCoordinate {
-	var T float64 = GetCurrentJulianCenturyRelativeToJ2000(datetime)
+	var T float64 = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
 
 	var D float64 = GetLunarMeanElongation(T)
 
@@ -646,11 +644,11 @@ func GetLunarHorizontalParallax(Δ float64) float64 {
 func GetLunarHourAngle(δ float64, latitude float64, elevation float64, π float64) float64 {
 	// observations on a sea horizon needing an elevation-of-observer correction
 	// (corrects for both apparent dip and terrestrial refraction):
-	var corr = -2.076 * math.Sqrt(elevation) * 1 / 60
+	var dip = getDipOfTheHorizon(elevation)
 
 	var h = 0.7275*π - 0.566667
 
-	var H_0 = acosx((sinx(h-corr) - (sinx(latitude) * sinx(δ))) / cosx(latitude) * cosx(δ))
+	var H_0 = acosx((sinx(h-dip) - (sinx(latitude) * sinx(δ))) / cosx(latitude) * cosx(δ))
 
 	return H_0
 }
@@ -735,73 +733,27 @@ func GetLunarTransitJulianDate(datetime time.Time, α float64, longitude float64
 	@returns the horizontal coordinates of the Moon for every minute of a given day.
 */
 func GetLunarHorizontalCoordinatesForDay(datetime time.Time, longitude float64, latitude float64) ([]TransitHorizontalCoordinate, error) {
-	// create an empty list of horizontalCoordinate structs:
-	horizontalCoordinates := make([]TransitHorizontalCoordinate, 1442)
-
-	// get the corresponding timezone for the longitude and latitude provided:
-	timezone := tzm.LatLngToTimezoneString(latitude, longitude)
-
-	location, err := time.LoadLocation(timezone)
-
-	if err != nil {
-		return horizontalCoordinates, err
-	}
-
-	var d = time.Date(datetime.Year(), datetime.Month(), datetime.Day(), 0, 0, 0, 0, location).In(time.UTC)
-
-	// Subtract one minute to ensure we are not over looking the rise time to be
-	d = d.Add(time.Minute * -1)
-
-	for i := range horizontalCoordinates {
-		// Get the current equatorial position of the moon:
-		var ec EclipticCoordinate = GetLunarEclipticPositionLawrence(d)
-
-		var eq EquatorialCoordinate = ConvertEclipticCoordinateToEquatorial(d, ec)
-
-		var hz HorizontalCoordinate = ConvertEquatorialCoordinateToHorizontal(d, longitude, latitude, eq)
-
-		if i > 0 {
-			horizontalCoordinates[i] = TransitHorizontalCoordinate{
-				Datetime: d.In(location),
-				Altitude: hz.Altitude,
-				Azimuth:  hz.Azimuth,
-				IsRise:   hz.Altitude > 0 && horizontalCoordinates[i-1].Altitude <= 0,
-				IsSet:    hz.Altitude < 0 && horizontalCoordinates[i-1].Altitude >= 0,
-			}
-		} else {
-			horizontalCoordinates[i] = TransitHorizontalCoordinate{
-				Datetime: d.In(location),
-				Altitude: hz.Altitude,
-				Azimuth:  hz.Azimuth,
-				IsRise:   false,
-				IsSet:    false,
-			}
-		}
-
-		d = d.Add(time.Minute)
-	}
-
-	return horizontalCoordinates[1:1441], nil
+	return GetBodyHorizontalCoordinatesForDay(datetime, BodyMoon, longitude, latitude, LawrenceEphemeris{})
 }
 
 /*
   GetLunarPhase
 
+	N.B. the elongation is from the accurate apparent Sun of VSOP87Ephemeris{}, as for GetLunarPhaseForEphemeris().
+
 	@param datetime - the datetime of the observer (in UTC)
-	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth (unused,
+	as the apparent Sun is geocentric)
 	@param geocentric ecliptic coordinate of type EclipticCoordinate { λ, β, Λ }
 
   @returns the lunar phase parameters, age (in degrees), the phase angle, the age (in days), the fraction and the illuminated percentage.
   @see p.179 of Lawrence, J.L. 2015. Celestial Calculations - A Gentle Introduction To Computational Astronomy. Cambridge, Ma: The MIT Press
 */
 func GetLunarPhase(datetime time.Time, longitude float64, ec EclipticCoordinate) LunarPhase {
-	var J float64 = GetMeanSolarTime(datetime, longitude)
+	// the VSOP87 ephemeris gives the position of the Sun for any datetime, and so never returns an error:
+	sun, _ := VSOP87Ephemeris{}.GetEclipticPosition(datetime, BodySun)
 
-	var Msol float64 = GetSolarMeanAnomaly(J)
-
-	var C float64 = GetSolarEquationOfCenter(Msol)
-
-	var λ float64 = GetSolarEclipticLongitude(Msol, C)
+	var λ float64 = sun.Longitude
 
 	var M float64 = GetLunarMeanAnomalyLawrence(datetime)
 
@@ -824,6 +776,62 @@ func GetLunarPhase(datetime time.Time, longitude float64, ec EclipticCoordinate)
 	}
 }
 
+/*
+	GetLunarPhaseForEphemeris()
+
+	N.B. where the ephemeris gives no distance for the Sun or the Moon, their mean distances are assumed.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param ephemeris - the ephemeris backend, e.g., MeeusEphemeris{}
+	@returns the lunar phase parameters, as given by the elongation of the Moon from the Sun of the ephemeris, or an error.
+	@see ch.48 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetLunarPhaseForEphemeris(datetime time.Time, ephemeris Ephemeris) (LunarPhase, error) {
+	sun, err := ephemeris.GetEclipticPosition(datetime, BodySun)
+
+	if err != nil {
+		return LunarPhase{}, err
+	}
+
+	moon, err := ephemeris.GetEclipticPosition(datetime, BodyMoon)
+
+	if err != nil {
+		return LunarPhase{}, err
+	}
+
+	var R float64 = sun.Δ
+
+	if R == 0 {
+		R = ASTRONOMICAL_UNIT_IN_KM
+	}
+
+	var Δ float64 = moon.Δ
+
+	if Δ == 0 {
+		Δ = 385000.56
+	}
+
+	// the geocentric elongation of the Moon from the Sun:
+	var ψ float64 = acosx(cosx(moon.Latitude) * cosx(moon.Longitude-sun.Longitude))
+
+	// the phase angle, i.e., the Sun-Moon-Earth angle:
+	var i float64 = atan2yx(R*sinx(ψ), Δ-R*cosx(ψ))
+
+	var K float64 = 100 * ((1 + cosx(i)) / 2)
+
+	var F float64 = (1 - cosx(ψ)) / 2
+
+	var days float64 = (F * LUNAR_MONTH_IN_DAYS)
+
+	return LunarPhase{
+		Age:          ψ,
+		Angle:        i,
+		Days:         days,
+		Fraction:     F,
+		Illumination: K,
+	}, nil
+}
+
 /*
 	GetMoonriseMoonsetTimes()
 
@@ -833,10 +841,23 @@ func GetLunarPhase(datetime time.Time, longitude float64, ec EclipticCoordinate)
 	@returns the times for when the Moon rises and sets, in the observer's local time, or an error.
 */
 func GetMoonriseMoonsetTimes(datetime time.Time, longitude float64, latitude float64) (Moon, error) {
+	return GetMoonriseMoonsetTimesForEphemeris(datetime, longitude, latitude, LawrenceEphemeris{})
+}
+
+/*
+	GetMoonriseMoonsetTimesForEphemeris()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param ephemeris - the ephemeris backend, e.g., MeeusEphemeris{}
+	@returns the times for when the Moon rises and sets, in the observer's local time, or an error.
+*/
+func GetMoonriseMoonsetTimesForEphemeris(datetime time.Time, longitude float64, latitude float64, ephemeris Ephemeris) (Moon, error) {
 	var rise time.Time = time.Time{}
 	var set time.Time = time.Time{}
 
-	horizontalCoordinates, err := GetLunarHorizontalCoordinatesForDay(datetime, longitude, latitude)
+	horizontalCoordinates, err := GetBodyHorizontalCoordinatesForDay(datetime, BodyMoon, longitude, latitude, ephemeris)
 
 	if err != nil {
 		return Moon{}, err
@@ -872,7 +893,20 @@ func GetMoonriseMoonsetTimes(datetime time.Time, longitude float64, latitude flo
 	@returns the times for when the Moon rises and sets, in UTC time, or an error.
 */
 func GetMoonriseMoonsetTimesInUTC(datetime time.Time, longitude float64, latitude float64) (Moon, error) {
-	var moon, err = GetMoonriseMoonsetTimes(datetime, longitude, latitude)
+	return GetMoonriseMoonsetTimesInUTCForEphemeris(datetime, longitude, latitude, LawrenceEphemeris{})
+}
+
+/*
+	GetMoonriseMoonsetTimesInUTCForEphemeris()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param ephemeris - the ephemeris backend, e.g., MeeusEphemeris{}
+	@returns the times for when the Moon rises and sets, in UTC time, or an error.
+*/
+func GetMoonriseMoonsetTimesInUTCForEphemeris(datetime time.Time, longitude float64, latitude float64, ephemeris Ephemeris) (Moon, error) {
+	var moon, err = GetMoonriseMoonsetTimesForEphemeris(datetime, longitude, latitude, ephemeris)
 
 	if err != nil {
 		return Moon{}, err
@@ -21,8 +21,8 @@ func TestGetLunarMeanLongitude(t *testing.T) {
 }
 
 func TestGetLunarMeanEclipticLongitude(t *testing.T) {
-	// Date of observation:
-	var datetime time.Time = time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC)
+	// Date of observation (as a reading of Terrestrial Time, TT):
+	var datetime time.Time = ConvertTTToUTC(time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC))
 
 	var got = GetLunarMeanEclipticLongitude(datetime)
 
@@ -34,8 +34,8 @@ func TestGetLunarMeanEclipticLongitude(t *testing.T) {
 }
 
 func TestGetLunarTrueEclipticLongitude(t *testing.T) {
-	// Date of observation:
-	var datetime time.Time = time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC)
+	// Date of observation (as a reading of Terrestrial Time, TT):
+	var datetime time.Time = ConvertTTToUTC(time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC))
 
 	var got = GetLunarTrueEclipticLongitude(datetime)
 
@@ -47,8 +47,8 @@ func TestGetLunarTrueEclipticLongitude(t *testing.T) {
 }
 
 func TestGetLunarMeanEclipticLongitudeOfTheAscendingNode(t *testing.T) {
-	// Date of observation:
-	var datetime time.Time = time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC)
+	// Date of observation (as a reading of Terrestrial Time, TT):
+	var datetime time.Time = ConvertTTToUTC(time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC))
 
 	var got = GetLunarMeanEclipticLongitudeOfTheAscendingNode(datetime)
 
@@ -60,8 +60,8 @@ func TestGetLunarMeanEclipticLongitudeOfTheAscendingNode(t *testing.T) {
 }
 
 func TestGetLunarCorrectedEclipticLongitudeOfTheAscendingNode(t *testing.T) {
-	// Date of observation:
-	var datetime time.Time = time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC)
+	// Date of observation (as a reading of Terrestrial Time, TT):
+	var datetime time.Time = ConvertTTToUTC(time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC))
 
 	var got = GetLunarCorrectedEclipticLongitudeOfTheAscendingNode(datetime)
 
@@ -96,8 +96,8 @@ func TestGetLunarMeanAnomaly(t *testing.T) {
 }
 
 func TestGetLunarTrueAnomaly(t *testing.T) {
-	// Date of observation:
-	var datetime time.Time = time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC)
+	// Date of observation (as a reading of Terrestrial Time, TT):
+	var datetime time.Time = ConvertTTToUTC(time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC))
 
 	var got float64 = GetLunarTrueAnomaly(datetime)
 
@@ -232,8 +232,8 @@ func TestGetLunarAnnualEquationCorrection(t *testing.T) {
 }
 
 func TestGetLunarEvectionCorrection(t *testing.T) {
-	// Date of observation:
-	var datetime time.Time = time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC)
+	// Date of observation (as a reading of Terrestrial Time, TT):
+	var datetime time.Time = ConvertTTToUTC(time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC))
 
 	var M float64 = GetLunarMeanAnomalyLawrence(datetime)
 
@@ -255,8 +255,8 @@ func TestGetLunarEvectionCorrection(t *testing.T) {
 }
 
 func TestGetLunarMeanAnomalyCorrection(t *testing.T) {
-	// Date of observation:
-	var datetime time.Time = time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC)
+	// Date of observation (as a reading of Terrestrial Time, TT):
+	var datetime time.Time = ConvertTTToUTC(time.Date(2015, 1, 2, 3, 0, 0, 0, time.UTC))
 
 	var M float64 = GetLunarMeanAnomalyLawrence(datetime)
 
@@ -282,7 +282,7 @@ func TestGetLunarMeanAnomalyCorrection(t *testing.T) {
 }
 
 func TestGetLunarEquatorialPositionRightAscension(t *testing.T) {
-	var eq EquatorialCoordinate = GetLunarEquatorialPosition(datetime)
+	var eq EquatorialCoordinate = GetLunarEquatorialPosition(ConvertTTToUTC(datetime))
 
 	var got float64 = eq.RightAscension
 
@@ -294,7 +294,7 @@ func TestGetLunarEquatorialPositionRightAscension(t *testing.T) {
 }
 
 func TestGetLunarEquatorialPositionDeclination(t *testing.T) {
-	var eq EquatorialCoordinate = GetLunarEquatorialPosition(datetime)
+	var eq EquatorialCoordinate = GetLunarEquatorialPosition(ConvertTTToUTC(datetime))
 
 	var got float64 = eq.Declination
 
@@ -359,7 +359,7 @@ func TestGetLunarEclipticPositionLatitudeAlt(t *testing.T) {
 }
 
 func TestGetLunarEclipticPositionDistance(t *testing.T) {
-	var ec EclipticCoordinate = GetLunarEclipticPosition(d)
+	var ec EclipticCoordinate = GetLunarEclipticPosition(ConvertTTToUTC(d))
 
 	var got float64 = ec.Δ
 
@@ -383,9 +383,9 @@ func TestGetLunarHorizontalParallax(t *testing.T) {
 }
 
 func TestGetLunarHourAngle(t *testing.T) {
-	var ec EclipticCoordinate = GetLunarEclipticPosition(datetime)
+	var ec EclipticCoordinate = GetLunarEclipticPosition(ConvertTTToUTC(datetime))
 
-	var eq EquatorialCoordinate = GetLunarEquatorialPosition(datetime)
+	var eq EquatorialCoordinate = GetLunarEquatorialPosition(ConvertTTToUTC(datetime))
 
 	var π float64 = GetLunarHorizontalParallax(ec.Δ)
 
@@ -449,7 +449,7 @@ func TestGetEclipticLatitudeInXHours(t *testing.T) {
 }
 
 func TestGetLunarTransitJulianDate(t *testing.T) {
-	var eq EquatorialCoordinate = GetLunarEquatorialPosition(d)
+	var eq EquatorialCoordinate = GetLunarEquatorialPosition(ConvertTTToUTC(d))
 
 	var ϑ float64 = GetApparentGreenwhichSiderealTimeInDegrees(d)
 
@@ -554,15 +554,15 @@ func TestGetLunarPhase(t *testing.T) {
 
 	got := GetLunarPhase(datetime, 78, EclipticCoordinate{Longitude: 50.279952, Latitude: -2.981288, Δ: 0})
 
-	var age float64 = 129.966690
+	var age float64 = 129.984423
 
-	var angle float64 = 49.924934
+	var angle float64 = 49.907230
 
-	var days float64 = 24.24562692920683
+	var days float64 = 24.25316817958653
 
-	var fraction float64 = 0.82
+	var fraction float64 = 0.821290
 
-	var illumination float64 = 82
+	var illumination float64 = 82.201355
 
 	if math.Abs(got.Age-age) > 0.1 {
 		t.Errorf("got %f, wanted %f", got.Age, age)
@@ -680,3 +680,102 @@ func TestGetMoonriseMoonsetTimesInUTC20210521(t *testing.T) {
 		t.Errorf("We're expecting the Moon to set at 12:15pm on 21st May 2021")
 	}
 }
+
+func TestGetMoonriseMoonsetTimesForEphemeris20210506(t *testing.T) {
+	// Date of observation:
+	var datetime time.Time = time.Date(2021, 5, 6, 0, 0, 0, 0, time.UTC)
+
+	want, err := GetMoonriseMoonsetTimes(datetime, longitude, latitude)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	got, err := GetMoonriseMoonsetTimesForEphemeris(datetime, longitude, latitude, LawrenceEphemeris{})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if !got.Rise.Equal(want.Rise) || !got.Set.Equal(want.Set) {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+
+	got, err = GetMoonriseMoonsetTimesInUTCForEphemeris(datetime, longitude, latitude, MeeusEphemeris{})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	// the Meeus and Lawrence lunar theories agree to within a few minutes of time:
+	if math.Abs(got.Rise.Sub(want.Rise).Minutes()) > 5 {
+		t.Errorf("got %q, wanted %q", got.Rise, want.Rise)
+	}
+
+	if math.Abs(got.Set.Sub(want.Set).Minutes()) > 5 {
+		t.Errorf("got %q, wanted %q", got.Set, want.Set)
+	}
+}
+
+type fixedEphemeris map[Body]EclipticCoordinate
+
+func (e fixedEphemeris) GetEclipticPosition(datetime time.Time, body Body) (EclipticCoordinate, error) {
+	return e[body], nil
+}
+
+func TestGetLunarPhaseForEphemerisMeeus(t *testing.T) {
+	// the illuminated fraction of the Moon on 1992 April 12 at 0h TD is 0.6786 (see ex.48.a of Meeus):
+	got, err := GetLunarPhaseForEphemeris(time.Date(1992, 4, 12, 0, 0, 0, 0, time.UTC), MeeusEphemeris{})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if math.Abs(got.Illumination-67.86) > 0.5 {
+		t.Errorf("got %f, wanted %f", got.Illumination, 67.86)
+	}
+}
+
+func TestGetLunarPhaseForEphemerisUsesItsOwnPositions(t *testing.T) {
+	var ephemeris = fixedEphemeris{
+		BodySun:  {Longitude: 10, Latitude: 0, Δ: ASTRONOMICAL_UNIT_IN_KM},
+		BodyMoon: {Longitude: 190, Latitude: 0, Δ: 384400},
+	}
+
+	got, err := GetLunarPhaseForEphemeris(datetime, ephemeris)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	// the Moon is at opposition to the Sun, and so is full:
+	if math.Abs(got.Illumination-100) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.Illumination, 100.0)
+	}
+
+	ephemeris[BodyMoon] = EclipticCoordinate{Longitude: 100, Latitude: 0, Δ: 384400}
+
+	got, _ = GetLunarPhaseForEphemeris(datetime, ephemeris)
+
+	// the Moon is at quadrature, and so (as the Sun is not infinitely distant) is just over half illuminated:
+	if math.Abs(got.Illumination-50.13) > 0.01 {
+		t.Errorf("got %f, wanted %f", got.Illumination, 50.13)
+	}
+}
+
+func TestGetLunarPhaseForEphemeris(t *testing.T) {
+	// Date of observation:
+	var datetime time.Time = time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, ephemeris := range []Ephemeris{LawrenceEphemeris{}, MeeusEphemeris{}, VSOP87Ephemeris{}} {
+		got, err := GetLunarPhaseForEphemeris(datetime, ephemeris)
+
+		if err != nil {
+			t.Errorf("got %q", err)
+		}
+
+		if math.Abs(got.Illumination-82) > 2 {
+			t.Errorf("got %f, wanted %f", got.Illumination, 82.0)
+		}
+	}
+}
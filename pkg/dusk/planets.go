@@ -0,0 +1,255 @@
+package dusk
+
+import (
+	"math"
+	"time"
+)
+
+type Planet int
+
+const (
+	Mercury = Planet(iota)
+	Venus
+	Earth
+	Mars
+	Jupiter
+	Saturn
+	Uranus
+	Neptune
+)
+
+/*
+	the astronomical unit, in km, as defined by the IAU 2012 Resolution B2
+*/
+var ASTRONOMICAL_UNIT_IN_KM float64 = 149597870.7
+
+/*
+	the speed of light, in AU per day
+*/
+var SPEED_OF_LIGHT_IN_AU_PER_DAY float64 = 173.1446326846693
+
+/*
+	the mean obliquity of the ecliptic at the standard epoch J2000 (in degrees)
+*/
+var J2000_OBLIQUITY float64 = 23.4392911
+
+/*
+	Keplerian elements (and their rates per Julian century) referred to the mean ecliptic and equinox of J2000,
+	valid for the time-interval 1800 AD - 2050 AD.
+
+	a - the semi-major axis (in AU), e - the eccentricity, I - the inclination (in degrees), L - the mean longitude (in degrees),
+	ϖ - the longitude of perihelion (in degrees), Ω - the longitude of the ascending node (in degrees)
+
+	@see Table 1 of Standish, E.M. 1992. Keplerian Elements for Approximate Positions of the Major Planets. JPL Solar System Dynamics.
+*/
+type keplerianElements struct{ a, e, I, L, ϖ, Ω, da, de, dI, dL, dϖ, dΩ float64 }
+
+var planetaryElements = [...]keplerianElements{
+	Mercury: {0.38709927, 0.20563593, 7.00497902, 252.25032350, 77.45779628, 48.33076593, 0.00000037, 0.00001906, -0.00594749, 149472.67411175, 0.16047689, -0.12534081},
+	Venus:   {0.72333566, 0.00677672, 3.39467605, 181.97909950, 131.60246718, 76.67984255, 0.00000390, -0.00004107, -0.00078890, 58517.81538729, 0.00268329, -0.27769418},
+	Earth:   {1.00000261, 0.01671123, -0.00001531, 100.46457166, 102.93768193, 0.0, 0.00000562, -0.00004392, -0.01294668, 35999.37244981, 0.32327364, 0.0},
+	Mars:    {1.52371034, 0.09339410, 1.84969142, -4.55343205, -23.94362959, 49.55953891, 0.00001847, 0.00007882, -0.00813131, 19140.30268499, 0.44441088, -0.29257343},
+	Jupiter: {5.20288700, 0.04838624, 1.30439695, 34.39644051, 14.72847983, 100.47390909, -0.00011607, -0.00013253, -0.00183714, 3034.74612775, 0.21252668, 0.20469106},
+	Saturn:  {9.53667594, 0.05386179, 2.48599187, 49.95424423, 92.59887831, 113.66242448, -0.00125060, -0.00050991, 0.00193609, 1222.49362201, -0.41897216, -0.28867794},
+	Uranus:  {19.18916464, 0.04725744, 0.77263783, 313.23810451, 170.95427630, 74.01692503, -0.00196176, -0.00004397, -0.00242939, 428.48202785, 0.40805281, 0.04240589},
+	Neptune: {30.06992276, 0.00859048, 1.77004347, -55.12002969, 44.96476227, 131.78422574, 0.00026291, 0.00005105, 0.00035372, 218.45945325, -0.32241464, -0.00508664},
+}
+
+var planetNames = [...]string{
+	Mercury: "Mercury",
+	Venus:   "Venus",
+	Earth:   "Earth",
+	Mars:    "Mars",
+	Jupiter: "Jupiter",
+	Saturn:  "Saturn",
+	Uranus:  "Uranus",
+	Neptune: "Neptune",
+}
+
+/*
+	String()
+
+	@returns the English name of the planet, e.g., "Jupiter"
+*/
+func (p Planet) String() string {
+	if p < Mercury || p > Neptune {
+		return "Unknown"
+	}
+
+	return planetNames[p]
+}
+
+/*
+	GetEccentricAnomaly()
+
+	@param M - the mean anomaly (in degrees)
+	@param e - the eccentricity of the orbit
+	@returns the eccentric anomaly (in degrees), as the solution of Kepler's equation M = E - e sin(E)
+	@see ch.30 p.193 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetEccentricAnomaly(M float64, e float64) float64 {
+	var m = M * degToRad
+
+	var E = m + e*math.Sin(m)
+
+	// iterate using Newton's method until the correction is negligible:
+	for i := 0; i < 50; i++ {
+		var ΔE = (m - (E - e*math.Sin(E))) / (1 - e*math.Cos(E))
+
+		E += ΔE
+
+		if math.Abs(ΔE) < 1e-12 {
+			break
+		}
+	}
+
+	return E * radToDeg
+}
+
+/*
+	getPlanetHeliocentricRectangularPosition()
+
+	@param planet - the planet of type Planet
+	@param T - the number of Julian centuries since J2000
+	@returns the heliocentric rectangular coordinates x, y, z (in AU) referred to the mean ecliptic and equinox of J2000
+	@see Standish, E.M. 1992. Keplerian Elements for Approximate Positions of the Major Planets. JPL Solar System Dynamics.
+*/
+func getPlanetHeliocentricRectangularPosition(planet Planet, T float64) (x float64, y float64, z float64) {
+	var el = planetaryElements[planet]
+
+	var a = el.a + el.da*T
+
+	var e = el.e + el.de*T
+
+	var I = el.I + el.dI*T
+
+	var L = el.L + el.dL*T
+
+	var ϖ = el.ϖ + el.dϖ*T
+
+	var Ω = el.Ω + el.dΩ*T
+
+	// the argument of perihelion:
+	var ω = ϖ - Ω
+
+	// the mean anomaly, corrected to between [-180°, 180°]:
+	var M = math.Mod(L-ϖ, 360)
+
+	if M > 180 {
+		M -= 360
+	}
+
+	if M < -180 {
+		M += 360
+	}
+
+	var E = GetEccentricAnomaly(M, e)
+
+	// the coordinates in the plane of the orbit, with the x-axis aligned towards perihelion:
+	var xʹ = a * (cosx(E) - e)
+
+	var yʹ = a * math.Sqrt(1-e*e) * sinx(E)
+
+	x = (cosx(ω)*cosx(Ω)-sinx(ω)*sinx(Ω)*cosx(I))*xʹ + (-sinx(ω)*cosx(Ω)-cosx(ω)*sinx(Ω)*cosx(I))*yʹ
+
+	y = (cosx(ω)*sinx(Ω)+sinx(ω)*cosx(Ω)*cosx(I))*xʹ + (-sinx(ω)*sinx(Ω)+cosx(ω)*cosx(Ω)*cosx(I))*yʹ
+
+	z = sinx(ω)*sinx(I)*xʹ + cosx(ω)*sinx(I)*yʹ
+
+	return x, y, z
+}
+
+/*
+	GetPlanetHeliocentricEclipticPosition()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param planet - the planet of type Planet
+	@returns the heliocentric ecliptic coordinate (λ, β, Δ in km) of the planet, referred to the mean ecliptic and equinox of J2000.
+*/
+func GetPlanetHeliocentricEclipticPosition(datetime time.Time, planet Planet) EclipticCoordinate {
+	var T = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
+
+	var x, y, z = getPlanetHeliocentricRectangularPosition(planet, T)
+
+	return convertRectangularToEclipticCoordinate(x, y, z)
+}
+
+/*
+	GetPlanetGeocentricEclipticPosition()
+
+	N.B. the position is corrected for light-time, and is referred to the mean ecliptic and equinox of J2000.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param planet - the planet of type Planet
+	@returns the geocentric ecliptic coordinate (λ, β, Δ in km) of the planet.
+*/
+func GetPlanetGeocentricEclipticPosition(datetime time.Time, planet Planet) EclipticCoordinate {
+	var T = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
+
+	var xe, ye, ze = getPlanetHeliocentricRectangularPosition(Earth, T)
+
+	var τ float64 = 0
+
+	var x, y, z float64
+
+	// iterate for the light-time, i.e., the planet is observed where it was when its light left it:
+	for i := 0; i < 3; i++ {
+		var xp, yp, zp = getPlanetHeliocentricRectangularPosition(planet, T-τ/36525)
+
+		x, y, z = xp-xe, yp-ye, zp-ze
+
+		τ = math.Sqrt(x*x+y*y+z*z) / SPEED_OF_LIGHT_IN_AU_PER_DAY
+	}
+
+	return convertRectangularToEclipticCoordinate(x, y, z)
+}
+
+/*
+	GetPlanetEquatorialPosition()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param planet - the planet of type Planet
+	@returns the geocentric equatorial coordinate { ra, dec } of the planet, referred to the mean equinox of date (in degrees).
+*/
+func GetPlanetEquatorialPosition(datetime time.Time, planet Planet) EquatorialCoordinate {
+	var ec = GetPlanetGeocentricEclipticPosition(datetime, planet)
+
+	var ε = J2000_OBLIQUITY
+
+	var α = atan2yx(sinx(ec.Longitude)*cosx(ε)-tanx(ec.Latitude)*sinx(ε), cosx(ec.Longitude))
+
+	// correct for negative angles
+	if α < 0 {
+		α += 360
+	}
+
+	var δ = asinx(sinx(ec.Latitude)*cosx(ε) + cosx(ec.Latitude)*sinx(ε)*sinx(ec.Longitude))
+
+	return ConvertJ2000EquatorialCoordinateToEpochOfDate(datetime, EquatorialCoordinate{
+		RightAscension: α,
+		Declination:    δ,
+	})
+}
+
+/*
+	convertRectangularToEclipticCoordinate()
+
+	@param x, y, z - the rectangular ecliptic coordinates (in AU)
+	@returns the spherical ecliptic coordinate (λ, β in degrees, Δ in km)
+*/
+func convertRectangularToEclipticCoordinate(x float64, y float64, z float64) EclipticCoordinate {
+	var r = math.Sqrt(x*x + y*y + z*z)
+
+	var λ = atan2yx(y, x)
+
+	// correct for negative angles
+	if λ < 0 {
+		λ += 360
+	}
+
+	return EclipticCoordinate{
+		Longitude: λ,
+		Latitude:  asinx(z / r),
+		Δ:         r * ASTRONOMICAL_UNIT_IN_KM,
+	}
+}
@@ -0,0 +1,75 @@
+package dusk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGetEccentricAnomaly(t *testing.T) {
+	// see example 30.a p.195 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	var got float64 = GetEccentricAnomaly(5, 0.1)
+
+	var want float64 = 5.554589
+
+	if math.Abs(got-want) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetPlanetString(t *testing.T) {
+	var got string = Jupiter.String()
+
+	var want string = "Jupiter"
+
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestGetPlanetHeliocentricEclipticPositionEarth(t *testing.T) {
+	var datetime time.Time = time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	var got = GetPlanetHeliocentricEclipticPosition(datetime, Earth)
+
+	// the Earth's heliocentric longitude at J2000 is the geocentric solar longitude less 180°:
+	var want float64 = 100.38
+
+	if math.Abs(got.Longitude-want) > 0.05 {
+		t.Errorf("got %f, wanted %f", got.Longitude, want)
+	}
+
+	if math.Abs(got.Δ/ASTRONOMICAL_UNIT_IN_KM-0.98333) > 0.0005 {
+		t.Errorf("got %f, wanted %f", got.Δ/ASTRONOMICAL_UNIT_IN_KM, 0.98333)
+	}
+}
+
+func TestGetPlanetEquatorialPositionVenus(t *testing.T) {
+	var datetime time.Time = time.Date(1992, 12, 20, 0, 0, 0, 0, time.UTC)
+
+	var got = GetPlanetEquatorialPosition(datetime, Venus)
+
+	// see example 33.a p.225 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	var want = EquatorialCoordinate{RightAscension: 316.172725, Declination: -18.888011}
+
+	if math.Abs(got.RightAscension-want.RightAscension) > 0.05 {
+		t.Errorf("got %f, wanted %f", got.RightAscension, want.RightAscension)
+	}
+
+	if math.Abs(got.Declination-want.Declination) > 0.05 {
+		t.Errorf("got %f, wanted %f", got.Declination, want.Declination)
+	}
+}
+
+func TestGetPlanetGeocentricEclipticPositionVenusDistance(t *testing.T) {
+	var datetime time.Time = time.Date(1992, 12, 20, 0, 0, 0, 0, time.UTC)
+
+	var got = GetPlanetGeocentricEclipticPosition(datetime, Venus)
+
+	// see example 33.a p.225 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	var want float64 = 0.910947
+
+	if math.Abs(got.Δ/ASTRONOMICAL_UNIT_IN_KM-want) > 0.001 {
+		t.Errorf("got %f, wanted %f", got.Δ/ASTRONOMICAL_UNIT_IN_KM, want)
+	}
+}
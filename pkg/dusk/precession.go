@@ -0,0 +1,255 @@
+package dusk
+
+import (
+	"math"
+	"time"
+)
+
+/*
+	GetPrecessedEquatorialCoordinate()
+
+	@param eq - the mean equatorial coordinate { ra, dec } referred to the starting epoch (in degrees)
+	@param JD0 - the Julian date of the starting epoch, e.g., J2000 (2451545.0)
+	@param JD - the Julian date of the final epoch
+	@returns the mean equatorial coordinate { ra, dec } referred to the final epoch (in degrees), using the IAU 1976 precession angles
+	@see eq.21.2, 21.3 & 21.4 p.126 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetPrecessedEquatorialCoordinate(eq EquatorialCoordinate, JD0 float64, JD float64) EquatorialCoordinate {
+	// the number of Julian centuries between J2000 and the starting epoch:
+	var T = (JD0 - J2000) / 36525
+
+	// the number of Julian centuries between the starting epoch and the final epoch:
+	var t = (JD - JD0) / 36525
+
+	var ζ = ((2306.2181+1.39656*T-0.000139*math.Pow(T, 2))*t + (0.30188-0.000344*T)*math.Pow(t, 2) + 0.017998*math.Pow(t, 3)) / 3600
+
+	var z = ((2306.2181+1.39656*T-0.000139*math.Pow(T, 2))*t + (1.09468+0.000066*T)*math.Pow(t, 2) + 0.018203*math.Pow(t, 3)) / 3600
+
+	var θ = ((2004.3109-0.85330*T-0.000217*math.Pow(T, 2))*t - (0.42665+0.000217*T)*math.Pow(t, 2) - 0.041833*math.Pow(t, 3)) / 3600
+
+	var A = cosx(eq.Declination) * sinx(eq.RightAscension+ζ)
+
+	var B = cosx(θ)*cosx(eq.Declination)*cosx(eq.RightAscension+ζ) - sinx(θ)*sinx(eq.Declination)
+
+	var C = sinx(θ)*cosx(eq.Declination)*cosx(eq.RightAscension+ζ) + cosx(θ)*sinx(eq.Declination)
+
+	// applies modulo correction to the angle, and ensures always positive:
+	var α = math.Mod(atan2yx(A, B)+z, 360)
+
+	// correct for negative angles
+	if α < 0 {
+		α += 360
+	}
+
+	// close to the celestial pole, use the more robust form of eq.21.4 for the declination:
+	var δ = asinx(C)
+
+	if math.Abs(C) > 0.99 {
+		δ = acosx(math.Sqrt(A*A+B*B)) * math.Copysign(1, C)
+	}
+
+	return EquatorialCoordinate{
+		RightAscension: α,
+		Declination:    δ,
+	}
+}
+
+/*
+	ConvertJ2000EquatorialCoordinateToEpochOfDate()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param eq - the mean equatorial coordinate { ra, dec } referred to the standard epoch J2000 (in degrees)
+	@returns the mean equatorial coordinate { ra, dec } referred to the equinox of date (in degrees), using the long-term precession
+*/
+func ConvertJ2000EquatorialCoordinateToEpochOfDate(datetime time.Time, eq EquatorialCoordinate) EquatorialCoordinate {
+	return GetLongTermPrecessedEquatorialCoordinate(eq, J2000, GetJulianEphemerisDate(datetime))
+}
+
+/*
+	ConvertEpochOfDateEquatorialCoordinateToJ2000()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param eq - the mean equatorial coordinate { ra, dec } referred to the equinox of date (in degrees)
+	@returns the mean equatorial coordinate { ra, dec } referred to the standard epoch J2000 (in degrees), using the long-term precession
+*/
+func ConvertEpochOfDateEquatorialCoordinateToJ2000(datetime time.Time, eq EquatorialCoordinate) EquatorialCoordinate {
+	return GetLongTermPrecessedEquatorialCoordinate(eq, GetJulianEphemerisDate(datetime), J2000)
+}
+
+/*
+	the periodic terms of the long-term precession of the equator, i.e., the period (in Julian centuries), and the cosine
+	and sine amplitudes of X and Y (in arcseconds)
+
+	@see Table 2 of Vondrák, J., Capitaine, N. & Wallace, P. 2011. New precession expressions, valid for long time intervals. A&A 534, A22.
+*/
+var longTermEquatorPeriodicTerms = [][5]float64{
+	{256.75, -819.940624, 75004.344875, 81491.287984, 1558.515853},
+	{708.15, -8444.676815, 624.033993, 787.163481, 7774.939698},
+	{274.20, 2600.009459, 1251.136893, 1251.296102, -2219.534038},
+	{241.45, 2755.175630, -1102.212834, -1257.950837, -2523.969396},
+	{2309.00, -167.659835, -2660.664980, -2966.799730, 247.850422},
+	{492.20, 871.855056, 699.291817, 639.744522, -846.485643},
+	{396.10, 44.769698, 153.167220, 131.600209, -1393.124055},
+	{288.90, -512.313065, -950.865637, -445.040117, 368.526116},
+	{231.10, -819.415595, 499.754645, 584.522874, 749.045012},
+	{1610.00, -538.071099, -145.188210, -89.756563, 444.704518},
+	{620.00, -189.793622, 558.116553, 524.429630, 235.934465},
+	{157.87, -402.922932, -23.923029, -13.549067, 374.049623},
+	{220.30, 179.516345, -165.405086, -210.157124, -171.330180},
+	{1200.00, -9.814756, 9.344131, -44.919798, -22.899655},
+}
+
+/*
+	the periodic terms of the long-term precession of the ecliptic, i.e., the period (in Julian centuries), and the cosine
+	and sine amplitudes of P and Q (in arcseconds)
+
+	@see Table 1 of Vondrák, J., Capitaine, N. & Wallace, P. 2011. New precession expressions, valid for long time intervals. A&A 534, A22.
+*/
+var longTermEclipticPeriodicTerms = [][5]float64{
+	{708.15, -5486.751211, -684.661560, 667.666730, -5523.863691},
+	{2309.00, -17.127623, 2446.283880, -2354.886252, -549.747450},
+	{1620.00, -617.517403, 399.671049, -428.152441, -310.998056},
+	{492.20, 413.442940, -356.652376, 376.202861, 421.535876},
+	{1183.00, 78.614193, -186.387003, 184.778874, -36.776172},
+	{622.00, -180.732815, -316.800070, 335.321713, -145.278396},
+	{882.00, -87.676083, 198.296701, -185.138669, -34.744450},
+	{547.00, 46.140315, 101.135679, -120.972830, 22.885731},
+}
+
+/*
+	getLongTermPrecessionPole()
+
+	@param T - the number of Julian centuries since J2000 (in TT)
+	@param terms - the periodic terms of the precession of the pole
+	@param polynomial - the polynomial coefficients of the two components of the pole (in arcseconds)
+	@returns the two components of the unit vector of the pole (in radians)
+*/
+func getLongTermPrecessionPole(T float64, terms [][5]float64, polynomial [2][4]float64) (float64, float64) {
+	var a, b float64 = 0, 0
+
+	for _, term := range terms {
+		var s, c = math.Sincos(2 * math.Pi * T / term[0])
+
+		a += c*term[1] + s*term[3]
+
+		b += c*term[2] + s*term[4]
+	}
+
+	var w float64 = 1
+
+	for i := 0; i < 4; i++ {
+		a += polynomial[0][i] * w
+
+		b += polynomial[1][i] * w
+
+		w *= T
+	}
+
+	// convert from arcseconds to radians:
+	return a / 3600 * math.Pi / 180, b / 3600 * math.Pi / 180
+}
+
+/*
+	getLongTermPrecessionMatrix()
+
+	@param JD - the Julian date (in TT) of the epoch
+	@returns the rotation matrix from the mean equator and equinox of J2000 to the mean equator and equinox of the epoch
+	@see eq.23 of Vondrák, J., Capitaine, N. & Wallace, P. 2011. New precession expressions, valid for long time intervals. A&A 534, A22.
+*/
+func getLongTermPrecessionMatrix(JD float64) [3][3]float64 {
+	var T = (JD - J2000) / 36525
+
+	// the equator pole, i.e., X and Y (eq.9 & Table 2):
+	var X, Y = getLongTermPrecessionPole(T, longTermEquatorPeriodicTerms, [2][4]float64{
+		{5453.282155, 0.4252841, -0.00037173, -0.000000152},
+		{-73750.930350, -0.7675452, -0.00018725, 0.000000231},
+	})
+
+	var equator = [3]float64{X, Y, math.Sqrt(math.Max(1-X*X-Y*Y, 0))}
+
+	// the ecliptic pole, i.e., P and Q (eq.8 & Table 1):
+	var P, Q = getLongTermPrecessionPole(T, longTermEclipticPeriodicTerms, [2][4]float64{
+		{5851.607687, -0.1189000, -0.00028913, 0.000000101},
+		{-1600.886300, 1.1689818, 0.00000020, -0.000000437},
+	})
+
+	var Z = math.Sqrt(math.Max(1-P*P-Q*Q, 0))
+
+	// the obliquity of the ecliptic at J2000 (in degrees):
+	var ε0 = 84381.406 / 3600
+
+	var ecliptic = [3]float64{P, -Q*cosx(ε0) - Z*sinx(ε0), -Q*sinx(ε0) + Z*cosx(ε0)}
+
+	// the equinox is the direction of the node of the ecliptic on the equator:
+	var equinox = cross(equator, ecliptic)
+
+	var n = math.Sqrt(equinox[0]*equinox[0] + equinox[1]*equinox[1] + equinox[2]*equinox[2])
+
+	for i := range equinox {
+		equinox[i] /= n
+	}
+
+	return [3][3]float64{equinox, cross(equator, equinox), equator}
+}
+
+/*
+	cross()
+
+	@returns the cross product of the vectors a and b
+*/
+func cross(a [3]float64, b [3]float64) [3]float64 {
+	return [3]float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
+
+/*
+	GetLongTermPrecessedEquatorialCoordinate()
+
+	N.B. unlike the IAU 1976 precession of GetPrecessedEquatorialCoordinate(), which is only valid for a few centuries
+	either side of J2000, the long-term precession is valid for ±200,000 years, and agrees with the IAU 2006 precession
+	to within a few milliarcseconds near J2000.
+
+	@param eq - the mean equatorial coordinate { ra, dec } referred to the starting epoch (in degrees)
+	@param JD0 - the Julian date (in TT) of the starting epoch, e.g., J2000 (2451545.0)
+	@param JD - the Julian date (in TT) of the final epoch
+	@returns the mean equatorial coordinate { ra, dec } referred to the final epoch (in degrees)
+	@see Vondrák, J., Capitaine, N. & Wallace, P. 2011. New precession expressions, valid for long time intervals. A&A 534, A22.
+*/
+func GetLongTermPrecessedEquatorialCoordinate(eq EquatorialCoordinate, JD0 float64, JD float64) EquatorialCoordinate {
+	var P0 = getLongTermPrecessionMatrix(JD0)
+
+	var P = getLongTermPrecessionMatrix(JD)
+
+	var v = [3]float64{
+		cosx(eq.Declination) * cosx(eq.RightAscension),
+		cosx(eq.Declination) * sinx(eq.RightAscension),
+		sinx(eq.Declination),
+	}
+
+	// rotate from the starting epoch to J2000 (by the transpose), then from J2000 to the final epoch:
+	var w [3]float64
+
+	for i := 0; i < 3; i++ {
+		w[i] = P0[0][i]*v[0] + P0[1][i]*v[1] + P0[2][i]*v[2]
+	}
+
+	for i := 0; i < 3; i++ {
+		v[i] = P[i][0]*w[0] + P[i][1]*w[1] + P[i][2]*w[2]
+	}
+
+	// applies modulo correction to the angle, and ensures always positive:
+	var α = math.Mod(atan2yx(v[1], v[0]), 360)
+
+	// correct for negative angles
+	if α < 0 {
+		α += 360
+	}
+
+	return EquatorialCoordinate{
+		RightAscension: α,
+		Declination:    atan2yx(v[2], math.Sqrt(v[0]*v[0]+v[1]*v[1])),
+	}
+}
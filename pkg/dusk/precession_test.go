@@ -0,0 +1,103 @@
+package dusk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGetPrecessedEquatorialCoordinateThetaPersei(t *testing.T) {
+	// the mean position of θ Persei at J2000, including the proper motion to the final epoch:
+	var eq = EquatorialCoordinate{RightAscension: 41.054063, Declination: 49.227750}
+
+	// see example 21.b p.128 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	var got = GetPrecessedEquatorialCoordinate(eq, J2000, 2462088.69)
+
+	var want = EquatorialCoordinate{RightAscension: 41.547214, Declination: 49.348483}
+
+	if math.Abs(got.RightAscension-want.RightAscension) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.RightAscension, want.RightAscension)
+	}
+
+	if math.Abs(got.Declination-want.Declination) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.Declination, want.Declination)
+	}
+}
+
+func TestGetPrecessedEquatorialCoordinateRoundTrip(t *testing.T) {
+	var datetime time.Time = time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var want = EquatorialCoordinate{RightAscension: 37.954561, Declination: 89.264109}
+
+	var got = ConvertEpochOfDateEquatorialCoordinateToJ2000(datetime, ConvertJ2000EquatorialCoordinateToEpochOfDate(datetime, want))
+
+	if math.Abs(got.RightAscension-want.RightAscension) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.RightAscension, want.RightAscension)
+	}
+
+	if math.Abs(got.Declination-want.Declination) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.Declination, want.Declination)
+	}
+}
+
+func TestGetLongTermPrecessedEquatorialCoordinateThetaPersei(t *testing.T) {
+	var eq = EquatorialCoordinate{RightAscension: 41.054063, Declination: 49.227750}
+
+	// the long-term precession agrees with the IAU 1976 precession to within an arcsecond over a few decades:
+	var got = GetLongTermPrecessedEquatorialCoordinate(eq, J2000, 2462088.69)
+
+	var want = EquatorialCoordinate{RightAscension: 41.547214, Declination: 49.348483}
+
+	if math.Abs(got.RightAscension-want.RightAscension) > 0.0003 {
+		t.Errorf("got %f, wanted %f", got.RightAscension, want.RightAscension)
+	}
+
+	if math.Abs(got.Declination-want.Declination) > 0.0003 {
+		t.Errorf("got %f, wanted %f", got.Declination, want.Declination)
+	}
+}
+
+func TestGetLongTermPrecessedEquatorialCoordinateThuban(t *testing.T) {
+	// the mean position of Thuban (α Draconis) at J2000:
+	var eq = EquatorialCoordinate{RightAscension: 211.097291, Declination: 64.375851}
+
+	// Thuban was the pole star of the Old Kingdom of Egypt, passing within a quarter degree of the pole in ~2800 BCE:
+	var JD = GetJulianDateFromCalendarDate(CalendarDate{Year: -2800, Month: 1, Day: 1, Calendar: JulianCalendar})
+
+	var got = GetLongTermPrecessedEquatorialCoordinate(eq, J2000, JD)
+
+	if got.Declination < 89.75 {
+		t.Errorf("got %f, wanted greater than %f", got.Declination, 89.75)
+	}
+}
+
+func TestGetLongTermPrecessedEquatorialCoordinateRoundTrip(t *testing.T) {
+	var want = EquatorialCoordinate{RightAscension: 279.234735, Declination: 38.783689}
+
+	var JD = GetJulianDateFromCalendarDate(CalendarDate{Year: -8000, Month: 6, Day: 21, Calendar: JulianCalendar})
+
+	var got = GetLongTermPrecessedEquatorialCoordinate(GetLongTermPrecessedEquatorialCoordinate(want, J2000, JD), JD, J2000)
+
+	if math.Abs(got.RightAscension-want.RightAscension) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.RightAscension, want.RightAscension)
+	}
+
+	if math.Abs(got.Declination-want.Declination) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.Declination, want.Declination)
+	}
+}
+
+func TestGetLongTermPrecessedEquatorialCoordinateObliquity(t *testing.T) {
+	// the pole of the ecliptic at J2000 (RA 18h, Dec 90° - ε0) remains at a declination of 90° - ε of the epoch:
+	var eq = EquatorialCoordinate{RightAscension: 270, Declination: 90 - 84381.406/3600}
+
+	var got = GetLongTermPrecessedEquatorialCoordinate(eq, J2000, J2000)
+
+	if math.Abs(got.Declination-eq.Declination) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.Declination, eq.Declination)
+	}
+
+	if math.Abs(got.RightAscension-eq.RightAscension) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got.RightAscension, eq.RightAscension)
+	}
+}
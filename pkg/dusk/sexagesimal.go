@@ -0,0 +1,365 @@
+package dusk
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+/*
+	the unit markers accepted between the sexagesimal components of an hour angle or an angle, e.g., "05h34m31.9s",
+	"+22°00′52″", "+22d00m52s" or "+22°00'52\"". Colons and whitespace are also accepted as separators.
+*/
+var sexagesimalHourMarkers = []string{"h", "H"}
+
+var sexagesimalDegreeMarkers = []string{"°", "º", "d", "D"}
+
+var sexagesimalSeparators = []string{"h", "H", "°", "º", "d", "D", "m", "M", "'", "′", "’", "s", "S", "\"", "″", "”", ":"}
+
+/*
+	parseSexagesimal()
+
+	@param s - the sexagesimal string to parse, e.g., "05h34m31.9s", "+22°00′52″", "05:34:31.9" or "-05 23 28"
+	@returns the decimal value in the unit of the leading component, whether the string was explicitly marked as hours or as degrees, and an error (if any).
+*/
+func parseSexagesimal(s string) (value float64, hours bool, degrees bool, err error) {
+	var str = strings.TrimSpace(s)
+
+	if len(str) == 0 {
+		return 0, false, false, fmt.Errorf("invalid sexagesimal value %q: empty string", s)
+	}
+
+	var sign float64 = 1
+
+	// handle both the ASCII hyphen-minus and the unicode minus sign:
+	switch {
+	case strings.HasPrefix(str, "+"):
+		str = strings.TrimPrefix(str, "+")
+	case strings.HasPrefix(str, "-"):
+		sign = -1
+		str = strings.TrimPrefix(str, "-")
+	case strings.HasPrefix(str, "−"):
+		sign = -1
+		str = strings.TrimPrefix(str, "−")
+	}
+
+	for _, m := range sexagesimalHourMarkers {
+		if strings.Contains(str, m) {
+			hours = true
+		}
+	}
+
+	for _, m := range sexagesimalDegreeMarkers {
+		if strings.Contains(str, m) {
+			degrees = true
+		}
+	}
+
+	if hours && degrees {
+		return 0, false, false, fmt.Errorf("invalid sexagesimal value %q: mixed hour and degree markers", s)
+	}
+
+	for _, sep := range sexagesimalSeparators {
+		str = strings.ReplaceAll(str, sep, " ")
+	}
+
+	var fields = strings.Fields(str)
+
+	if len(fields) == 0 || len(fields) > 3 {
+		return 0, false, false, fmt.Errorf("invalid sexagesimal value %q: expected between one and three components", s)
+	}
+
+	for i, f := range fields {
+		v, err := strconv.ParseFloat(f, 64)
+
+		if err != nil || v < 0 || math.IsInf(v, 0) || math.IsNaN(v) {
+			return 0, false, false, fmt.Errorf("invalid sexagesimal value %q: malformed component %q", s, f)
+		}
+
+		// only the final component may carry a fractional part:
+		if i < len(fields)-1 && v != math.Trunc(v) {
+			return 0, false, false, fmt.Errorf("invalid sexagesimal value %q: only the last component may be fractional", s)
+		}
+
+		// minutes and seconds must be strictly less than sixty:
+		if i > 0 && v >= 60 {
+			return 0, false, false, fmt.Errorf("invalid sexagesimal value %q: component %q is out of range [0, 60)", s, f)
+		}
+
+		value += v / math.Pow(60, float64(i))
+	}
+
+	return sign * value, hours, degrees, nil
+}
+
+/*
+	ParseHours()
+
+	@param s - the sexagesimal hour string, e.g., "05h34m31.9s", "05:34:31.9", "05 34 31.9" or "5.5759"
+	@returns the decimal hours represented by the string, or an error.
+*/
+func ParseHours(s string) (float64, error) {
+	h, _, degrees, err := parseSexagesimal(s)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if degrees {
+		return 0, fmt.Errorf("invalid hour value %q: unexpected degree marker", s)
+	}
+
+	return h, nil
+}
+
+/*
+	ParseDegrees()
+
+	@param s - the sexagesimal angle string, e.g., "+22°00′52″", "+22d00m52s", "-05:23:28" or "-5.3911"
+	@returns the decimal degrees represented by the string, or an error.
+*/
+func ParseDegrees(s string) (float64, error) {
+	d, hours, _, err := parseSexagesimal(s)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if hours {
+		return 0, fmt.Errorf("invalid degree value %q: unexpected hour marker", s)
+	}
+
+	return d, nil
+}
+
+/*
+	ParseRightAscension()
+
+	N.B. values marked with an "h", or given as multiple unmarked components (e.g., "05:34:31.9" or "05 34 31.9") are
+	interpreted as hours; values marked with a degree sign or given as a single unmarked decimal (e.g., "83.633") are
+	interpreted as degrees.
+
+	@param s - the right ascension string, e.g., "05h34m31.9s", "05:34:31.9" or "83.633"
+	@returns the right ascension in degrees, in the range [0°, 360°), or an error.
+*/
+func ParseRightAscension(s string) (float64, error) {
+	v, hours, degrees, err := parseSexagesimal(s)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if v < 0 {
+		return 0, fmt.Errorf("invalid right ascension %q: value must not be negative", s)
+	}
+
+	// multiple unmarked components follow the conventional hours notation:
+	if hours || (!degrees && len(strings.Fields(strings.ReplaceAll(s, ":", " "))) > 1) {
+		v *= 15
+	}
+
+	if v >= 360 {
+		return 0, fmt.Errorf("invalid right ascension %q: value must be less than 24h (360°)", s)
+	}
+
+	return v, nil
+}
+
+/*
+	ParseDeclination()
+
+	@param s - the declination string, e.g., "+22°00′52″", "+22d00m52s", "-05:23:28" or "-5.3911"
+	@returns the declination in degrees, in the range [-90°, +90°], or an error.
+*/
+func ParseDeclination(s string) (float64, error) {
+	δ, err := ParseDegrees(s)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if math.Abs(δ) > 90 {
+		return 0, fmt.Errorf("invalid declination %q: value must be in the range [-90°, +90°]", s)
+	}
+
+	return δ, nil
+}
+
+/*
+	ParseEquatorialCoordinate()
+
+	@param s - the combined right ascension and declination string, e.g., "05h34m31.9s +22°00′52″" or "05 34 31.9 +22 00 52"
+	@returns the equatorial coordinate { ra, dec } in degrees, or an error.
+*/
+func ParseEquatorialCoordinate(s string) (EquatorialCoordinate, error) {
+	var str = strings.TrimSpace(s)
+
+	var ra, dec string
+
+	// the declination is most commonly delimited by its explicit sign (the right ascension is never signed):
+	if i := strings.IndexAny(str, "+-−"); i > 0 {
+		ra, dec = str[:i], str[i:]
+	} else {
+		var fields = strings.Fields(str)
+
+		if len(fields)%2 != 0 {
+			return EquatorialCoordinate{}, fmt.Errorf("invalid equatorial coordinate %q: unable to separate right ascension and declination", s)
+		}
+
+		ra, dec = strings.Join(fields[:len(fields)/2], " "), strings.Join(fields[len(fields)/2:], " ")
+	}
+
+	α, err := ParseRightAscension(ra)
+
+	if err != nil {
+		return EquatorialCoordinate{}, err
+	}
+
+	δ, err := ParseDeclination(dec)
+
+	if err != nil {
+		return EquatorialCoordinate{}, err
+	}
+
+	return EquatorialCoordinate{
+		RightAscension: α,
+		Declination:    δ,
+	}, nil
+}
+
+/*
+	formatSexagesimal()
+
+	@param value - the decimal value to format (in hours or degrees)
+	@param precision - the number of decimal places of the final (seconds) component
+	@param units - the markers to place after each of the three components, e.g., { "h", "m", "s" }
+	@param width - the minimum number of digits of the leading component
+	@param signed - whether to always prefix the value with an explicit sign
+	@returns the sexagesimal representation of the value
+*/
+func formatSexagesimal(value float64, precision int, units [3]string, width int, signed bool) string {
+	if precision < 0 {
+		precision = 0
+	}
+
+	var sign = "+"
+
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+
+	// round once, in integer units of the last displayed digit, so that e.g., 59.96s carries over correctly:
+	var scale = math.Pow(10, float64(precision))
+
+	var total = math.Round(value * 3600 * scale)
+
+	var s = math.Mod(total, 60*scale) / scale
+
+	var m = math.Mod(math.Floor(total/(60*scale)), 60)
+
+	var d = math.Floor(total / (3600 * scale))
+
+	// a value which rounds to zero should not carry a negative sign:
+	if total == 0 {
+		sign = "+"
+	}
+
+	var secondsWidth = 2
+
+	if precision > 0 {
+		secondsWidth = precision + 3
+	}
+
+	var str = fmt.Sprintf("%0*.0f%s%02.0f%s%0*.*f%s", width, d, units[0], m, units[1], secondsWidth, precision, s, units[2])
+
+	if signed || sign == "-" {
+		return sign + str
+	}
+
+	return str
+}
+
+/*
+	FormatHours()
+
+	@param hours - the value in decimal hours, e.g., a right ascension or a sidereal time
+	@param precision - the number of decimal places of the seconds component
+	@returns the value formatted as e.g., "05h34m31.94s", normalised to the range [0h, 24h)
+*/
+func FormatHours(hours float64, precision int) string {
+	var h = math.Mod(hours, 24)
+
+	// correct for negative hour angles (24 hours is equivalent to 360°)
+	if h < 0 {
+		h += 24
+	}
+
+	var str = formatSexagesimal(h, precision, [3]string{"h", "m", "s"}, 2, false)
+
+	// rounding may carry the value up to exactly 24h, which is equivalent to 0h:
+	if strings.HasPrefix(str, "24h") {
+		return formatSexagesimal(0, precision, [3]string{"h", "m", "s"}, 2, false)
+	}
+
+	return str
+}
+
+/*
+	FormatDegrees()
+
+	@param degrees - the signed angle in decimal degrees, e.g., a declination or an altitude
+	@param precision - the number of decimal places of the arcseconds component
+	@returns the value formatted as e.g., "+22°00′52.2″"
+*/
+func FormatDegrees(degrees float64, precision int) string {
+	return formatSexagesimal(degrees, precision, [3]string{"°", "′", "″"}, 2, true)
+}
+
+/*
+	FormatEquatorialCoordinate()
+
+	@param eq - the equatorial coordinate of type EquatorialCoordinate { ra, dec } in degrees
+	@param precision - the number of decimal places of the seconds and arcseconds components
+	@returns the equatorial coordinate formatted as e.g., "05h34m31.94s +22°00′52.20″"
+*/
+func FormatEquatorialCoordinate(eq EquatorialCoordinate, precision int) string {
+	return FormatHours(eq.RightAscension/15, precision) + " " + FormatDegrees(eq.Declination, precision)
+}
+
+/*
+	FormatHorizontalCoordinate()
+
+	@param hz - the horizontal coordinate of type HorizontalCoordinate { alt, az } in degrees
+	@param precision - the number of decimal places of the arcseconds components
+	@returns the horizontal coordinate formatted as e.g., "+45°30′00.0″ 123°15′00.0″" (altitude, azimuth)
+*/
+func FormatHorizontalCoordinate(hz HorizontalCoordinate, precision int) string {
+	var az = math.Mod(hz.Azimuth, 360)
+
+	// correct for negative angles
+	if az < 0 {
+		az += 360
+	}
+
+	var A = formatSexagesimal(az, precision, [3]string{"°", "′", "″"}, 3, false)
+
+	// rounding may carry the azimuth up to exactly 360°, which is equivalent to 0°:
+	if strings.HasPrefix(A, "360°") {
+		A = formatSexagesimal(0, precision, [3]string{"°", "′", "″"}, 3, false)
+	}
+
+	return FormatDegrees(hz.Altitude, precision) + " " + A
+}
+
+/*
+	FormatSiderealTime()
+
+	@param ST - the sidereal time in decimal hours, e.g., as returned by GetLocalSiderealTime()
+	@param precision - the number of decimal places of the seconds component
+	@returns the sidereal time formatted as e.g., "15h27m50.26s"
+*/
+func FormatSiderealTime(ST float64, precision int) string {
+	return FormatHours(ST, precision)
+}
@@ -0,0 +1,230 @@
+package dusk
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseHours(t *testing.T) {
+	var inputs = []string{"05h34m31.9s", "05:34:31.9", "05 34 31.9", "5h 34m 31.9s", "05H34M31.9S"}
+
+	var want float64 = 5 + 34.0/60 + 31.9/3600
+
+	for _, input := range inputs {
+		got, err := ParseHours(input)
+
+		if err != nil {
+			t.Errorf("got %q", err)
+		}
+
+		if math.Abs(got-want) > 0.0000001 {
+			t.Errorf("%s: got %f, wanted %f", input, got, want)
+		}
+	}
+}
+
+func TestParseDegrees(t *testing.T) {
+	var inputs = []string{"+22°00′52″", "+22d00m52s", "22°00'52\"", "+22:00:52", "22 00 52", "+22º00’52”"}
+
+	var want float64 = 22 + 52.0/3600
+
+	for _, input := range inputs {
+		got, err := ParseDegrees(input)
+
+		if err != nil {
+			t.Errorf("got %q", err)
+		}
+
+		if math.Abs(got-want) > 0.0000001 {
+			t.Errorf("%s: got %f, wanted %f", input, got, want)
+		}
+	}
+}
+
+func TestParseDegreesNegativeZeroDegrees(t *testing.T) {
+	var inputs = []string{"-00°30′00″", "−00:30:00", "-0 30 0"}
+
+	var want float64 = -0.5
+
+	for _, input := range inputs {
+		got, err := ParseDegrees(input)
+
+		if err != nil {
+			t.Errorf("got %q", err)
+		}
+
+		if math.Abs(got-want) > 0.0000001 {
+			t.Errorf("%s: got %f, wanted %f", input, got, want)
+		}
+	}
+}
+
+func TestParseDegreesInvalid(t *testing.T) {
+	var inputs = []string{"", "abc", "22°60′00″", "22.5°30′00″", "1 2 3 4", "05h34m31.9s"}
+
+	for _, input := range inputs {
+		_, err := ParseDegrees(input)
+
+		if err == nil {
+			t.Errorf("%q: expected an error", input)
+		}
+	}
+}
+
+func TestParseRightAscension(t *testing.T) {
+	var inputs = []string{"05h34m31.94s", "05:34:31.94", "83.633083", "83°37′59.1″"}
+
+	var want float64 = 83.633083
+
+	for _, input := range inputs {
+		got, err := ParseRightAscension(input)
+
+		if err != nil {
+			t.Errorf("got %q", err)
+		}
+
+		if math.Abs(got-want) > 0.00001 {
+			t.Errorf("%s: got %f, wanted %f", input, got, want)
+		}
+	}
+}
+
+func TestParseRightAscensionOutOfRange(t *testing.T) {
+	var inputs = []string{"24h00m00s", "-01h00m00s", "360.5"}
+
+	for _, input := range inputs {
+		_, err := ParseRightAscension(input)
+
+		if err == nil {
+			t.Errorf("%q: expected an error", input)
+		}
+	}
+}
+
+func TestParseDeclinationOutOfRange(t *testing.T) {
+	_, err := ParseDeclination("+91°00′00″")
+
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestParseEquatorialCoordinate(t *testing.T) {
+	var inputs = []string{"05h34m31.94s +22°00′52.2″", "05 34 31.94 +22 00 52.2", "05:34:31.94 22:00:52.2", "05h34m31.94s+22d00m52.2s"}
+
+	var want = EquatorialCoordinate{RightAscension: 83.633083, Declination: 22.0145}
+
+	for _, input := range inputs {
+		got, err := ParseEquatorialCoordinate(input)
+
+		if err != nil {
+			t.Errorf("got %q", err)
+		}
+
+		if math.Abs(got.RightAscension-want.RightAscension) > 0.00001 {
+			t.Errorf("%s: got %f, wanted %f", input, got.RightAscension, want.RightAscension)
+		}
+
+		if math.Abs(got.Declination-want.Declination) > 0.00001 {
+			t.Errorf("%s: got %f, wanted %f", input, got.Declination, want.Declination)
+		}
+	}
+}
+
+func TestParseEquatorialCoordinateSouthern(t *testing.T) {
+	got, err := ParseEquatorialCoordinate("05h35m17.3s -05°23′28″")
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	var want float64 = -(5 + 23.0/60 + 28.0/3600)
+
+	if math.Abs(got.Declination-want) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got.Declination, want)
+	}
+}
+
+func TestFormatHours(t *testing.T) {
+	var got string = FormatHours(5+34.0/60+31.94/3600, 2)
+
+	var want string = "05h34m31.94s"
+
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestFormatHoursCarry(t *testing.T) {
+	var got string = FormatHours(5+59.0/60+59.96/3600, 1)
+
+	var want string = "06h00m00.0s"
+
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestFormatHoursWrapsAround(t *testing.T) {
+	var got string = FormatHours(23+59.0/60+59.9999/3600, 2)
+
+	var want string = "00h00m00.00s"
+
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestFormatDegrees(t *testing.T) {
+	var got string = FormatDegrees(-(5 + 23.0/60 + 28.0/3600), 0)
+
+	var want string = "-05°23′28″"
+
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestFormatDegreesRoundTrip(t *testing.T) {
+	var want float64 = -0.123456
+
+	got, err := ParseDegrees(FormatDegrees(want, 3))
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if math.Abs(got-want) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestFormatEquatorialCoordinate(t *testing.T) {
+	var got string = FormatEquatorialCoordinate(EquatorialCoordinate{RightAscension: 83.633083, Declination: 22.0145}, 1)
+
+	var want string = "05h34m31.9s +22°00′52.2″"
+
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestFormatHorizontalCoordinate(t *testing.T) {
+	var got string = FormatHorizontalCoordinate(HorizontalCoordinate{Altitude: -12.5, Azimuth: 5.25}, 0)
+
+	var want string = "-12°30′00″ 005°15′00″"
+
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestFormatSiderealTime(t *testing.T) {
+	var got string = FormatSiderealTime(GetGreenwhichSiderealTime(datetime), 2)
+
+	var want string = "15h27m50.26s"
+
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
@@ -0,0 +1,232 @@
+package dusk
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+type Shadow struct {
+	/*
+		Length - the length of the shadow on flat ground, in the same units as the height of the object
+	*/
+	Length float64 `json:"length"`
+	/*
+		Azimuth - the direction in which the shadow falls, eastwards from north, in degrees
+	*/
+	Azimuth float64 `json:"azimuth"`
+}
+
+type GroundCoordinate struct {
+	/*
+		East - the distance east of the origin (west is negative)
+	*/
+	East float64 `json:"east"`
+	/*
+		North - the distance north of the origin (south is negative)
+	*/
+	North float64 `json:"north"`
+}
+
+/*
+	GetShadowLength()
+
+	@param height - the height of the object above flat ground
+	@param altitude - the altitude of the Sun (in degrees)
+	@returns the length of the shadow of the object, in the same units as the height, or +Inf if the Sun is not above the horizon
+*/
+func GetShadowLength(height float64, altitude float64) float64 {
+	if altitude <= 0 {
+		return math.Inf(1)
+	}
+
+	return height / tanx(altitude)
+}
+
+/*
+	getSolarApparentHorizontalPosition()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@returns the horizontal coordinate of the Sun, with the altitude corrected for atmospheric refraction down to a geometric
+	altitude of -1°
+*/
+func getSolarApparentHorizontalPosition(datetime time.Time, longitude float64, latitude float64) HorizontalCoordinate {
+	var hz = GetSolarHorizontalPosition(datetime, longitude, latitude)
+
+	if app := GetApparentAltitude(hz.Altitude); app != nil {
+		hz.Altitude = *app
+	}
+
+	return hz
+}
+
+/*
+	GetShadow()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param height - the height of the object above flat ground
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@returns the length and direction of the shadow cast by the Sun, where the length is +Inf if the Sun is not above the horizon
+*/
+func GetShadow(datetime time.Time, height float64, longitude float64, latitude float64) Shadow {
+	var hz = getSolarApparentHorizontalPosition(datetime, longitude, latitude)
+
+	return Shadow{
+		Length:  GetShadowLength(height, hz.Altitude),
+		Azimuth: math.Mod(hz.Azimuth+180, 360),
+	}
+}
+
+/*
+	GetBoxShadowPolygon()
+
+	N.B. the box is centred on the origin, with its depth along the orientation and its width perpendicular to it.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param width - the width of the box
+	@param depth - the depth of the box
+	@param height - the height of the box
+	@param orientation - the azimuth of the depth axis of the box, eastwards from north (in degrees)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@returns the vertices, counter-clockwise, of the outline on flat ground of the box and its shadow, or nil if the Sun is
+	not above the horizon
+*/
+func GetBoxShadowPolygon(datetime time.Time, width float64, depth float64, height float64, orientation float64, longitude float64, latitude float64) []GroundCoordinate {
+	var shadow = GetShadow(datetime, height, longitude, latitude)
+
+	if math.IsInf(shadow.Length, 1) {
+		return nil
+	}
+
+	// the unit vectors of the width and depth axes of the box:
+	var u = GroundCoordinate{East: cosx(orientation), North: -sinx(orientation)}
+
+	var v = GroundCoordinate{East: sinx(orientation), North: cosx(orientation)}
+
+	// the displacement of the top of the box by its shadow:
+	var s = GroundCoordinate{East: shadow.Length * sinx(shadow.Azimuth), North: shadow.Length * cosx(shadow.Azimuth)}
+
+	var vertices = make([]GroundCoordinate, 0, 8)
+
+	for _, corner := range [4][2]float64{{-1, -1}, {1, -1}, {1, 1}, {-1, 1}} {
+		var p = GroundCoordinate{
+			East:  corner[0]*width/2*u.East + corner[1]*depth/2*v.East,
+			North: corner[0]*width/2*u.North + corner[1]*depth/2*v.North,
+		}
+
+		vertices = append(vertices, p, GroundCoordinate{East: p.East + s.East, North: p.North + s.North})
+	}
+
+	return getConvexHull(vertices)
+}
+
+/*
+	getConvexHull()
+
+	@param points - the points on flat ground
+	@returns the vertices of the convex hull of the points, counter-clockwise, by Andrew's monotone chain algorithm
+*/
+func getConvexHull(points []GroundCoordinate) []GroundCoordinate {
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].East != points[j].East {
+			return points[i].East < points[j].East
+		}
+
+		return points[i].North < points[j].North
+	})
+
+	if len(points) < 3 {
+		return points
+	}
+
+	var hull = make([]GroundCoordinate, 0, 2*len(points))
+
+	// the lower hull from west to east, then the upper hull from east to west:
+	for pass := 0; pass < 2; pass++ {
+		var start = len(hull)
+
+		for i := range points {
+			var p = points[i]
+
+			if pass == 1 {
+				p = points[len(points)-1-i]
+			}
+
+			for len(hull) >= start+2 && getCrossProduct(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+				hull = hull[:len(hull)-1]
+			}
+
+			hull = append(hull, p)
+		}
+
+		// the last point of each half is the first point of the other:
+		hull = hull[:len(hull)-1]
+	}
+
+	return hull
+}
+
+/*
+	getCrossProduct()
+
+	@returns the z component of the cross product (b - a) × (c - a), i.e., positive if a, b, c turn counter-clockwise
+*/
+func getCrossProduct(a GroundCoordinate, b GroundCoordinate, c GroundCoordinate) float64 {
+	return (b.East-a.East)*(c.North-a.North) - (b.North-a.North)*(c.East-a.East)
+}
+
+/*
+	GetFacadeSunlightDuration()
+
+	N.B. the Sun is taken to shine on the vertical façade whenever it is above the horizon and in front of the façade,
+	i.e., within 90° of azimuth of its outward normal, with the declination of the Sun at local apparent noon.
+
+	@param datetime - the datetime of the observer, whose calendar date is used
+	@param orientation - the azimuth of the outward normal of the façade, eastwards from north (in degrees)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@returns the duration of direct sunlight on the façade over the day, to the minute
+*/
+func GetFacadeSunlightDuration(datetime time.Time, orientation float64, longitude float64, latitude float64) time.Duration {
+	var noon = GetSolarNoonInUTC(datetime, longitude)
+
+	var δ = GetApparentSolarEquatorialPosition(noon).Declination
+
+	var minutes = 0
+
+	for m := 0; m < 1440; m++ {
+		// the hour angle of the Sun in the middle of each minute of the day, about noon:
+		var H = (float64(m)+0.5)/4 - 180
+
+		var hz = getHorizontalCoordinateFromHourAngle(H, δ, latitude)
+
+		if hz.Altitude > 0 && cosx(hz.Azimuth-orientation) > 0 {
+			minutes++
+		}
+	}
+
+	return time.Duration(minutes) * time.Minute
+}
+
+/*
+	GetFacadeSunlightDurationForYear()
+
+	@param datetime - the datetime of the observer, whose year is used
+	@param orientation - the azimuth of the outward normal of the façade, eastwards from north (in degrees)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@returns the total duration of direct sunlight on the façade over the days of the year
+*/
+func GetFacadeSunlightDurationForYear(datetime time.Time, orientation float64, longitude float64, latitude float64) time.Duration {
+	var total time.Duration = 0
+
+	for d := time.Date(datetime.Year(), 1, 1, 0, 0, 0, 0, time.UTC); d.Year() == datetime.Year(); d = d.AddDate(0, 0, 1) {
+		total += GetFacadeSunlightDuration(d, orientation, longitude, latitude)
+	}
+
+	return total
+}
@@ -0,0 +1,135 @@
+package dusk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGetShadowLength(t *testing.T) {
+	if got := GetShadowLength(10, 45); math.Abs(got-10) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got, 10.0)
+	}
+
+	if got := GetShadowLength(10, 30); math.Abs(got-10*math.Sqrt(3)) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got, 10*math.Sqrt(3))
+	}
+
+	if got := GetShadowLength(10, -1); !math.IsInf(got, 1) {
+		t.Errorf("got %f, wanted %f", got, math.Inf(1))
+	}
+}
+
+func TestGetShadow(t *testing.T) {
+	// the Sun transits ~1° south of the zenith in mid-May at Hawaii, and so a short shadow falls to the north:
+	var datetime = GetSolarNoonInUTC(time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC), longitude)
+
+	var got = GetShadow(datetime, 10, longitude, latitude)
+
+	if math.Abs(math.Mod(got.Azimuth+180, 360)-180) > 1 {
+		t.Errorf("got %f, wanted %f", got.Azimuth, 0.0)
+	}
+
+	if got.Length > 0.25 {
+		t.Errorf("got %f, wanted less than %f", got.Length, 0.25)
+	}
+
+	// the Sun is below the horizon at midnight:
+	got = GetShadow(datetime.Add(12*time.Hour), 10, longitude, latitude)
+
+	if !math.IsInf(got.Length, 1) {
+		t.Errorf("got %f, wanted %f", got.Length, math.Inf(1))
+	}
+
+	// the refracted Sun remains in view at sunset while its geometric altitude is -0.3°, and so casts a long shadow:
+	sunset, err := getSolarEventForEphemeris(datetime, 1, -0.3, longitude, latitude, VSOP87Ephemeris{})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	got = GetShadow(sunset, 10, longitude, latitude)
+
+	if math.IsInf(got.Length, 1) || got.Length < 1000 {
+		t.Errorf("got %f, wanted a finite shadow longer than %f", got.Length, 1000.0)
+	}
+}
+
+func TestGetBoxShadowPolygon(t *testing.T) {
+	var datetime = time.Date(2021, 5, 14, 3, 0, 0, 0, time.UTC)
+
+	var width, depth, height, orientation = 20.0, 10.0, 15.0, 30.0
+
+	var got = GetBoxShadowPolygon(datetime, width, depth, height, orientation, longitude, latitude)
+
+	if len(got) < 4 || len(got) > 6 {
+		t.Fatalf("got %d vertices, wanted between 4 and 6", len(got))
+	}
+
+	// the signed area (by the shoelace formula) is positive for counter-clockwise vertices:
+	var area = 0.0
+
+	for i := range got {
+		var j = (i + 1) % len(got)
+
+		area += (got[i].East*got[j].North - got[j].East*got[i].North) / 2
+	}
+
+	// the box sweeps its shadow across the ground, i.e., the area is that of the footprint and the two swept faces:
+	var shadow = GetShadow(datetime, height, longitude, latitude)
+
+	var s = GroundCoordinate{East: shadow.Length * sinx(shadow.Azimuth), North: shadow.Length * cosx(shadow.Azimuth)}
+
+	var want = width*depth + width*math.Abs(s.East*sinx(orientation)+s.North*cosx(orientation)) + depth*math.Abs(s.East*cosx(orientation)-s.North*sinx(orientation))
+
+	if math.Abs(area-want) > 0.001 {
+		t.Errorf("got %f, wanted %f", area, want)
+	}
+
+	// the Sun is below the horizon at night:
+	if got := GetBoxShadowPolygon(time.Date(2021, 5, 14, 10, 0, 0, 0, time.UTC), width, depth, height, orientation, longitude, latitude); got != nil {
+		t.Errorf("got %v, wanted nil", got)
+	}
+}
+
+func TestGetFacadeSunlightDuration(t *testing.T) {
+	// London, UK at the December solstice:
+	var datetime = time.Date(2021, 12, 21, 0, 0, 0, 0, time.UTC)
+
+	var south = GetFacadeSunlightDuration(datetime, 180, -0.1278, 51.5074)
+
+	var north = GetFacadeSunlightDuration(datetime, 0, -0.1278, 51.5074)
+
+	// the centre of the Sun is above the geometric horizon for ~7h36m, always to the south:
+	if math.Abs(south.Minutes()-456) > 2 {
+		t.Errorf("got %v, wanted %v", south, 456*time.Minute)
+	}
+
+	if north != 0 {
+		t.Errorf("got %v, wanted %v", north, 0)
+	}
+
+	// the east and west façades share the day between them:
+	var east = GetFacadeSunlightDuration(datetime, 90, -0.1278, 51.5074)
+
+	var west = GetFacadeSunlightDuration(datetime, 270, -0.1278, 51.5074)
+
+	if math.Abs((east + west - south).Minutes()) > 1 {
+		t.Errorf("got %v, wanted %v", east+west, south)
+	}
+}
+
+func TestGetFacadeSunlightDurationForYear(t *testing.T) {
+	var south = GetFacadeSunlightDurationForYear(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 180, -0.1278, 51.5074)
+
+	var north = GetFacadeSunlightDurationForYear(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 0, -0.1278, 51.5074)
+
+	// the Sun is above the horizon for half the year, on average ~4383 hours, between the south and north façades:
+	if math.Abs((south+north).Hours()-4383) > 50 {
+		t.Errorf("got %f, wanted %f", (south + north).Hours(), 4383.0)
+	}
+
+	if south < 2*north {
+		t.Errorf("got %v, wanted more than twice %v", south, north)
+	}
+}
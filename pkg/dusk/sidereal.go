@@ -0,0 +1,350 @@
+package dusk
+
+import (
+	"math"
+	"time"
+)
+
+type SiderealTimeModel int
+
+const (
+	/*
+		LawrenceSiderealTime - the Greenwhich sidereal time of Lawrence, as given by GetGreenwhichSiderealTime()
+	*/
+	LawrenceSiderealTime = SiderealTimeModel(iota)
+	/*
+		MeeusMeanSiderealTime - the mean sidereal time of the IAU 1982 expression (eq.12.4 of Meeus), at the instant
+	*/
+	MeeusMeanSiderealTime
+	/*
+		MeeusApparentSiderealTime - the mean sidereal time of Meeus, corrected for the nutation in right ascension
+	*/
+	MeeusApparentSiderealTime
+	/*
+		IAU2006MeanSiderealTime - the mean sidereal time of the IAU 2006 precession, from the Earth rotation angle
+	*/
+	IAU2006MeanSiderealTime
+	/*
+		IAU2006ApparentSiderealTime - the apparent sidereal time of the IAU 2006 precession, i.e., the Earth rotation angle less the equation of the origins
+	*/
+	IAU2006ApparentSiderealTime
+)
+
+/*
+	GetEarthRotationAngle()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the Earth rotation angle, θ, i.e., the angle between the celestial and terrestrial intermediate origins (in degrees)
+	@see eq.5.15 of Petit, G. & Luzum, B. (eds.) 2010. IERS Conventions (2010). IERS Technical Note No. 36.
+*/
+func GetEarthRotationAngle(datetime time.Time) float64 {
+	// the Earth rotation angle is a function of Universal Time (UT1):
+	var JD JulianDate = GetPreciseJulianDate(ConvertUTCToUT1(datetime))
+
+	// the number of days of UT1 since J2000 (which begins at noon, as does the Julian day):
+	var Du = float64(JD.Day-2451545) + JD.Fraction
+
+	// the whole turns of the Earth are separated out, to retain precision:
+	var θ = math.Mod(JD.Fraction+0.7790572732640+0.00273781191135448*Du, 1) * 360
+
+	// correct for negative angles
+	if θ < 0 {
+		θ += 360
+	}
+
+	return θ
+}
+
+/*
+	getIAU2006SiderealTimePolynomial()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the accumulated precession in right ascension, i.e., the difference GMST - ERA (in degrees)
+	@see eq.5.32 of Petit, G. & Luzum, B. (eds.) 2010. IERS Conventions (2010). IERS Technical Note No. 36.
+*/
+func getIAU2006SiderealTimePolynomial(datetime time.Time) float64 {
+	// the precession is evaluated in Terrestrial Time (TT):
+	var t = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
+
+	return (0.014506 + 4612.156534*t + 1.3915817*math.Pow(t, 2) - 0.00000044*math.Pow(t, 3) - 0.000029956*math.Pow(t, 4) - 0.0000000368*math.Pow(t, 5)) / 3600
+}
+
+/*
+	GetEquationOfTheEquinoxes()
+
+	N.B. the nutation is that of the 63 periodic terms of the IAU 1980 theory, which agrees with the IAU 2000A theory to a
+	few hundredths of an arcsecond, i.e., to a few milliseconds of time, and not to the microarcseconds of the latter.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the equation of the equinoxes, i.e., the nutation in right ascension, Δψ cos ε, with the largest of the
+	complementary terms (in degrees)
+	@see ch.12 p.88 & ch.22 p.143 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	@see eq.5.35 & Table 5.2e of Petit, G. & Luzum, B. (eds.) 2010. IERS Conventions (2010). IERS Technical Note No. 36.
+*/
+func GetEquationOfTheEquinoxes(datetime time.Time) float64 {
+	// the nutation is evaluated in Terrestrial Time (TT):
+	var J float64 = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
+
+	var Ω float64 = GetLunarLongitudeOfTheAscendingNode(J)
+
+	var Δψ, Δε = getNutationIAU1980(J)
+
+	var ε float64 = GetMeanObliquityOfTheEcliptic(J) + Δε
+
+	// the complementary terms, in the longitude of the ascending node of the Moon:
+	var CT = (0.00264096*sinx(Ω) + 0.00006352*sinx(2*Ω)) / 3600
+
+	return Δψ*cosx(ε) + CT
+}
+
+/*
+	GetEquationOfTheOrigins()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the equation of the origins, i.e., the difference ERA - GAST, or the distance from the equinox to the celestial
+	intermediate origin along the true equator (in degrees)
+	@see eq.5.31 of Petit, G. & Luzum, B. (eds.) 2010. IERS Conventions (2010). IERS Technical Note No. 36.
+*/
+func GetEquationOfTheOrigins(datetime time.Time) float64 {
+	return -getIAU2006SiderealTimePolynomial(datetime) - GetEquationOfTheEquinoxes(datetime)
+}
+
+/*
+	GetSiderealTime()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param model - the model of sidereal time, e.g., IAU2006ApparentSiderealTime
+	@returns the Greenwhich sidereal time in hours (in decimal format), as given by the model, or NaN for an unknown model
+*/
+func GetSiderealTime(datetime time.Time, model SiderealTimeModel) float64 {
+	var θ float64
+
+	switch model {
+	case MeeusMeanSiderealTime, MeeusApparentSiderealTime:
+		// get the Julian date, in Universal Time (UT1):
+		var JD JulianDate = GetPreciseJulianDate(ConvertUTCToUT1(datetime))
+
+		var D = float64(JD.Day-2451545) + JD.Fraction
+
+		var T = D / 36525
+
+		// the whole turns of the Earth, i.e., 360° for each whole day, are separated out to retain precision:
+		θ = 280.46061837 + 360*JD.Fraction + 0.98564736629*D + 0.000387933*math.Pow(T, 2) - math.Pow(T, 3)/38710000
+
+		if model == MeeusApparentSiderealTime {
+			θ += GetEquationOfTheEquinoxes(datetime)
+		}
+	case IAU2006MeanSiderealTime:
+		θ = GetEarthRotationAngle(datetime) + getIAU2006SiderealTimePolynomial(datetime)
+	case IAU2006ApparentSiderealTime:
+		θ = GetEarthRotationAngle(datetime) - GetEquationOfTheOrigins(datetime)
+	case LawrenceSiderealTime:
+		return GetGreenwhichSiderealTime(datetime)
+	default:
+		return math.NaN()
+	}
+
+	// applies modulo correction to the angle, and ensures always positive:
+	θ = math.Mod(θ, 360)
+
+	// correct for negative angles
+	if θ < 0 {
+		θ += 360
+	}
+
+	return θ / 15
+}
+
+/*
+	GetLocalSiderealTimeForModel()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param model - the model of sidereal time, e.g., IAU2006ApparentSiderealTime
+	@returns the local sidereal time in hours (in decimal format), relative to some location's longitude
+*/
+func GetLocalSiderealTimeForModel(datetime time.Time, longitude float64, model SiderealTimeModel) float64 {
+	var GST = GetSiderealTime(datetime, model)
+
+	var d = (GST + longitude/15.0) / 24.0
+
+	d = d - math.Floor(d)
+
+	// correct for negative hour angles (24 hours is equivalent to 360°)
+	if d < 0 {
+		d += 1
+	}
+
+	return 24.0 * d
+}
+
+// the ratio of the mean sidereal rate to the mean solar rate, i.e., sidereal hours elapsed per hour of Universal Time:
+var SIDEREAL_RATE float64 = 1.00273790935
+
+// the length of the mean sidereal day, in units of Universal Time (i.e., 23h 56m 4.0905s):
+var SIDEREAL_DAY time.Duration = time.Duration(float64(24*time.Hour) / SIDEREAL_RATE)
+
+type SiderealTimeInterval struct {
+	/*
+		From - the datetime (in UTC) at which the local sidereal time enters the window
+	*/
+	From time.Time `json:"from"`
+	/*
+		Until - the datetime (in UTC) at which the local sidereal time leaves the window
+	*/
+	Until time.Time `json:"until"`
+	/*
+		Duration - the civil duration of the interval
+	*/
+	Duration time.Duration `json:"duration"`
+}
+
+/*
+	getSiderealHourDifference()
+
+	@param a - the sidereal time in hours (in decimal format)
+	@param b - the sidereal time in hours (in decimal format)
+	@returns the signed difference a - b, wrapped into the range [-12, 12) hours
+*/
+func getSiderealHourDifference(a float64, b float64) float64 {
+	var d = math.Mod(a-b+12, 24)
+
+	// correct for negative hour angles
+	if d < 0 {
+		d += 24
+	}
+
+	return d - 12
+}
+
+/*
+	getUniversalTimeNearLocalSiderealTime()
+
+	@param datetime - the datetime (in UTC) near to which the local sidereal time occurs
+	@param LST - the local sidereal time in hours (in decimal format)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@returns the datetime (in UTC) within half a sidereal day of the datetime at which the local sidereal time occurs
+*/
+func getUniversalTimeNearLocalSiderealTime(datetime time.Time, LST float64, longitude float64) time.Time {
+	// the sidereal time is near linear in time, and so a few Newton iterations suffice to converge to the nanosecond:
+	for i := 0; i < 4; i++ {
+		var Δ = getSiderealHourDifference(LST, GetLocalSiderealTime(datetime, longitude)) / SIDEREAL_RATE
+
+		datetime = datetime.Add(time.Duration(Δ * float64(time.Hour)))
+	}
+
+	return datetime
+}
+
+/*
+	GetUniversalTimesForLocalSiderealTime()
+
+	@param from - the datetime (in UTC) from which to search (inclusive)
+	@param until - the datetime (in UTC) until which to search (exclusive)
+	@param LST - the local sidereal time in hours (in decimal format)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@returns every datetime (in UTC) within the range at which the local sidereal time occurs, in chronological order
+	N.B. a sidereal day is shorter than a solar day by ~3m 56s, and so a given LST occurs twice on one civil date roughly once a year.
+*/
+func GetUniversalTimesForLocalSiderealTime(from time.Time, until time.Time, LST float64, longitude float64) []time.Time {
+	var times = []time.Time{}
+
+	// begin at the first occurrence at or after the start of the range:
+	var Δ = getSiderealHourDifference(LST, GetLocalSiderealTime(from, longitude))
+
+	if Δ < 0 {
+		Δ += 24
+	}
+
+	var datetime = getUniversalTimeNearLocalSiderealTime(from.Add(time.Duration(Δ/SIDEREAL_RATE*float64(time.Hour))), LST, longitude)
+
+	// the refinement may settle a hair before the start of the range, on the previous sidereal day:
+	if datetime.Before(from) && from.Sub(datetime) > SIDEREAL_DAY/2 {
+		datetime = getUniversalTimeNearLocalSiderealTime(datetime.Add(SIDEREAL_DAY), LST, longitude)
+	}
+
+	for !datetime.Before(from) && datetime.Before(until) {
+		times = append(times, datetime)
+
+		datetime = getUniversalTimeNearLocalSiderealTime(datetime.Add(SIDEREAL_DAY), LST, longitude)
+	}
+
+	return times
+}
+
+/*
+	GetLocalSiderealTimeIntervals()
+
+	@param from - the datetime (in UTC) from which to search
+	@param until - the datetime (in UTC) until which to search
+	@param start - the local sidereal time in hours (in decimal format) at which the window opens
+	@param end - the local sidereal time in hours (in decimal format) at which the window closes, which may wrap past 24h, e.g., 22h to 2h
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@returns every civil-time interval within the range during which the local sidereal time lies within the window, clipped to the range
+*/
+func GetLocalSiderealTimeIntervals(from time.Time, until time.Time, start float64, end float64, longitude float64) []SiderealTimeInterval {
+	var intervals = []SiderealTimeInterval{}
+
+	if !until.After(from) {
+		return intervals
+	}
+
+	// the width of the window in sidereal hours, where a window opening and closing at the same LST spans the whole sidereal day:
+	var width = math.Mod(end-start, 24)
+
+	if width <= 0 {
+		width += 24
+	}
+
+	if width >= 24 {
+		return append(intervals, SiderealTimeInterval{
+			From:     from,
+			Until:    until,
+			Duration: until.Sub(from),
+		})
+	}
+
+	// search from one sidereal day earlier, to capture a window that is already open at the start of the range:
+	var openings = GetUniversalTimesForLocalSiderealTime(from.Add(-SIDEREAL_DAY), until, start, longitude)
+
+	for _, opening := range openings {
+		var closing = getUniversalTimeNearLocalSiderealTime(opening.Add(time.Duration(width/SIDEREAL_RATE*float64(time.Hour))), end, longitude)
+
+		if !closing.After(from) {
+			continue
+		}
+
+		if opening.Before(from) {
+			opening = from
+		}
+
+		if closing.After(until) {
+			closing = until
+		}
+
+		intervals = append(intervals, SiderealTimeInterval{
+			From:     opening,
+			Until:    closing,
+			Duration: closing.Sub(opening),
+		})
+	}
+
+	return intervals
+}
+
+/*
+	GetLocalSiderealTimeWindow()
+
+	@param from - the datetime (in UTC) at the start of the civil-time interval
+	@param until - the datetime (in UTC) at the end of the civil-time interval
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@returns the local sidereal times in hours (in decimal format) at which the interval opens and closes, which may wrap past 24h,
+	or [0, 24) if the interval spans a whole sidereal day or more
+*/
+func GetLocalSiderealTimeWindow(from time.Time, until time.Time, longitude float64) (float64, float64) {
+	if until.Sub(from) >= SIDEREAL_DAY {
+		return 0, 24
+	}
+
+	return GetLocalSiderealTime(from, longitude), GetLocalSiderealTime(until, longitude)
+}
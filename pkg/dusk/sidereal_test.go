@@ -0,0 +1,262 @@
+package dusk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGetEarthRotationAngle(t *testing.T) {
+	// see t_sofa_c.c, t_era00 of the IAU SOFA Software Collection, i.e., UT1 2007 October 15 00:00:00:
+	var datetime time.Time = time.Date(2007, 10, 15, 0, 0, 0, 0, time.UTC)
+
+	var got float64 = GetEarthRotationAngle(datetime)
+
+	var want float64 = 0.4022837240028158102 * 180 / math.Pi
+
+	if math.Abs(got-want) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetEarthRotationAngleAtJ2000(t *testing.T) {
+	var got float64 = GetEarthRotationAngle(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	var want float64 = 0.7790572732640 * 360
+
+	if math.Abs(got-want) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetSiderealTimeIAU2006Mean(t *testing.T) {
+	// see t_sofa_c.c, t_gmst06 of the IAU SOFA Software Collection, i.e., UT1 2006 January 1 00:00:00:
+	var datetime time.Time = time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var got float64 = GetSiderealTime(datetime, IAU2006MeanSiderealTime)
+
+	var want float64 = 1.754174971870091203 * 180 / math.Pi / 15
+
+	if math.Abs(got-want) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetSiderealTimeIAU2006Apparent(t *testing.T) {
+	// see t_sofa_c.c, t_gst06a of the IAU SOFA Software Collection, i.e., UT1 2006 January 1 00:00:00:
+	var datetime time.Time = time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var got float64 = GetSiderealTime(datetime, IAU2006ApparentSiderealTime)
+
+	var want float64 = 1.754166137675019159 * 180 / math.Pi / 15
+
+	// the abridged nutation is accurate to within an arcsecond, i.e., ~0.07 seconds of time:
+	if math.Abs(got-want)*3600 > 0.07 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetEquationOfTheOrigins(t *testing.T) {
+	// see t_sofa_c.c, t_eo06a of the IAU SOFA Software Collection, i.e., TT 2006 January 1 00:00:00:
+	var datetime time.Time = ConvertTTToUTC(time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	var got float64 = GetEquationOfTheOrigins(datetime)
+
+	var want float64 = -0.1332882371941833644e-2 * 180 / math.Pi
+
+	// the nutation of the IAU 1980 theory agrees with that of the IAU 2000A theory to a few hundredths of an arcsecond:
+	if math.Abs(got-want)*3600 > 0.01 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetSiderealTimeMeeusMean(t *testing.T) {
+	// see ex.12.b p.89 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	var datetime time.Time = time.Date(1987, 4, 10, 19, 21, 0, 0, time.UTC)
+
+	var got float64 = GetSiderealTime(datetime, MeeusMeanSiderealTime) * 15
+
+	var want float64 = 128.7378734
+
+	if math.Abs(got-want) > 0.000001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetSiderealTimeMeeusApparent(t *testing.T) {
+	// see ex.12.a p.88 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	var datetime time.Time = time.Date(1987, 4, 10, 0, 0, 0, 0, time.UTC)
+
+	var got float64 = GetSiderealTime(datetime, MeeusApparentSiderealTime)
+
+	var want float64 = GetApparentGreenwhichSiderealTimeInDegrees(datetime) / 15
+
+	if math.Abs(got-want) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetSiderealTimeModelsAgree(t *testing.T) {
+	var models = []SiderealTimeModel{
+		MeeusMeanSiderealTime,
+		MeeusApparentSiderealTime,
+		IAU2006MeanSiderealTime,
+		IAU2006ApparentSiderealTime,
+	}
+
+	var want = GetSiderealTime(datetime, LawrenceSiderealTime)
+
+	for _, model := range models {
+		var got = GetSiderealTime(datetime, model)
+
+		// all models agree to within a second of time:
+		if math.Abs(got-want)*3600 > 1 {
+			t.Errorf("got %f, wanted %f", got, want)
+		}
+	}
+
+	// the IAU 2006 and IAU 1982 mean sidereal times agree to within a few milliseconds of time:
+	var got = GetSiderealTime(datetime, IAU2006MeanSiderealTime)
+
+	want = GetSiderealTime(datetime, MeeusMeanSiderealTime)
+
+	if math.Abs(got-want)*3600 > 0.005 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetLocalSiderealTimeForModel(t *testing.T) {
+	var got float64 = GetLocalSiderealTimeForModel(datetime, longitude, LawrenceSiderealTime)
+
+	var want float64 = GetLocalSiderealTime(datetime, longitude)
+
+	if got != want {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+
+	got = GetLocalSiderealTimeForModel(datetime, longitude, IAU2006ApparentSiderealTime)
+
+	want = math.Mod(GetSiderealTime(datetime, IAU2006ApparentSiderealTime)+longitude/15+24, 24)
+
+	if math.Abs(got-want) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetSiderealTimeUnknownModel(t *testing.T) {
+	// an unknown model is not silently taken to be that of Lawrence:
+	if got := GetSiderealTime(datetime, SiderealTimeModel(99)); !math.IsNaN(got) {
+		t.Errorf("got %f, wanted NaN", got)
+	}
+}
+
+func TestGetUniversalTimesForLocalSiderealTime(t *testing.T) {
+	var from time.Time = time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC)
+
+	var until time.Time = from.Add(7 * 24 * time.Hour)
+
+	var got []time.Time = GetUniversalTimesForLocalSiderealTime(from, until, 13.5, longitude)
+
+	if len(got) != 7 {
+		t.Fatalf("got %d, wanted %d", len(got), 7)
+	}
+
+	for i, datetime := range got {
+		var LST = GetLocalSiderealTime(datetime, longitude)
+
+		if math.Abs(LST-13.5)*3600 > 0.001 {
+			t.Errorf("got %f, wanted %f", LST, 13.5)
+		}
+
+		if i > 0 && math.Abs(datetime.Sub(got[i-1]).Seconds()-SIDEREAL_DAY.Seconds()) > 0.01 {
+			t.Errorf("got %v, wanted %v", datetime.Sub(got[i-1]), SIDEREAL_DAY)
+		}
+	}
+}
+
+func TestGetUniversalTimesForLocalSiderealTimeTwiceOnOneDate(t *testing.T) {
+	// the LST of 0h at Greenwhich occurs twice on the civil date near the autumnal equinox:
+	var from time.Time = time.Date(2021, 9, 21, 0, 0, 0, 0, time.UTC)
+
+	var got []time.Time = GetUniversalTimesForLocalSiderealTime(from, from.Add(24*time.Hour), 0.01, 0)
+
+	if len(got) != 2 {
+		t.Fatalf("got %d, wanted %d", len(got), 2)
+	}
+
+	if got[0].Day() != 21 || got[1].Day() != 21 {
+		t.Errorf("got %v, wanted both on the 21st", got)
+	}
+}
+
+func TestGetUniversalTimesForLocalSiderealTimeAcrossYear(t *testing.T) {
+	var from time.Time = time.Date(2021, 12, 30, 0, 0, 0, 0, time.UTC)
+
+	var got []time.Time = GetUniversalTimesForLocalSiderealTime(from, from.Add(4*24*time.Hour), 6, longitude)
+
+	if len(got) != 4 {
+		t.Fatalf("got %d, wanted %d", len(got), 4)
+	}
+}
+
+func TestGetLocalSiderealTimeIntervals(t *testing.T) {
+	var from time.Time = time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC)
+
+	var until time.Time = from.Add(3 * 24 * time.Hour)
+
+	// a window that wraps past 24h:
+	var got []SiderealTimeInterval = GetLocalSiderealTimeIntervals(from, until, 22, 2, longitude)
+
+	if len(got) < 3 || len(got) > 4 {
+		t.Fatalf("got %d, wanted 3 or 4", len(got))
+	}
+
+	for _, interval := range got {
+		if interval.From.Before(from) || interval.Until.After(until) {
+			t.Errorf("got %v, wanted within [%v, %v]", interval, from, until)
+		}
+
+		if interval.Duration != interval.Until.Sub(interval.From) {
+			t.Errorf("got %v, wanted %v", interval.Duration, interval.Until.Sub(interval.From))
+		}
+
+		// every interval is at most 4 sidereal hours:
+		if interval.Duration > time.Duration(4/SIDEREAL_RATE*float64(time.Hour))+time.Second {
+			t.Errorf("got %v, wanted at most 4 sidereal hours", interval.Duration)
+		}
+
+		var LST = GetLocalSiderealTime(interval.From.Add(interval.Duration/2), longitude)
+
+		if LST < 22 && LST > 2 {
+			t.Errorf("got %f, wanted within [22, 2)", LST)
+		}
+	}
+}
+
+func TestGetLocalSiderealTimeIntervalsWholeDay(t *testing.T) {
+	var from time.Time = time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC)
+
+	var got []SiderealTimeInterval = GetLocalSiderealTimeIntervals(from, from.Add(24*time.Hour), 6, 6, longitude)
+
+	if len(got) != 1 || got[0].Duration != 24*time.Hour {
+		t.Errorf("got %v, wanted a single interval of 24h", got)
+	}
+}
+
+func TestGetLocalSiderealTimeWindow(t *testing.T) {
+	var from time.Time = time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC)
+
+	var start, end = GetLocalSiderealTimeWindow(from, from.Add(2*time.Hour), longitude)
+
+	var intervals = GetLocalSiderealTimeIntervals(from, from.Add(2*time.Hour), start, end, longitude)
+
+	if len(intervals) != 1 || math.Abs(intervals[0].Duration.Seconds()-7200) > 0.01 {
+		t.Errorf("got %v, wanted a single interval of 2h", intervals)
+	}
+
+	start, end = GetLocalSiderealTimeWindow(from, from.Add(24*time.Hour), longitude)
+
+	if start != 0 || end != 24 {
+		t.Errorf("got [%f, %f], wanted [0, 24]", start, end)
+	}
+}
@@ -92,6 +92,30 @@ func GetSolarMeanLongitude(J float64) float64 {
 	return L
 }
 
+/*
+	getDipOfTheHorizon()
+
+	@param elevation - is the elevation (above sea level) in meters of some observer on Earth
+	@returns the dip of a sea horizon below the astronomical horizon (in degrees), including terrestrial refraction, by which
+	the rise of a body is earlier, and its set later, for an elevated observer
+*/
+func getDipOfTheHorizon(elevation float64) float64 {
+	return 2.076 * math.Sqrt(elevation) / 60
+}
+
+/*
+	getSolarStandardAltitude()
+
+	@param degreesBelowHorizon - is the degrees below horizon, as for GetSunriseSunsetTimes()
+	@param elevation - is the elevation (above sea level) in meters of some observer on Earth
+	@returns the standard altitude of the centre of the Sun at its rise and set (in degrees), i.e., -0.83° for the
+	refraction and semi-diameter, lowered by the dip of the horizon
+	@see ch.15 p.101 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func getSolarStandardAltitude(degreesBelowHorizon float64, elevation float64) float64 {
+	return -0.83 + degreesBelowHorizon - getDipOfTheHorizon(elevation)
+}
+
 /*
 	GetSolarHourAngle()
 
@@ -108,14 +132,16 @@ func GetSolarMeanLongitude(J float64) float64 {
 func GetSolarHourAngle(δ float64, degreesBelowHorizon float64, latitude float64, elevation float64) float64 {
 	// observations on a sea horizon needing an elevation-of-observer correction
 	// (corrects for both apparent dip and terrestrial refraction):
-	var corr = -degreesBelowHorizon + -2.076*math.Sqrt(elevation)*1/60
+	var h0 = getSolarStandardAltitude(degreesBelowHorizon, elevation)
 
-	return acosx((sinx(-0.83-corr) - (sinx(latitude) * sinx(δ))) / cosx(latitude) * cosx(δ))
+	return acosx((sinx(h0) - (sinx(latitude) * sinx(δ))) / cosx(latitude) * cosx(δ))
 }
 
 /*
 	GetSunriseSunsetTimes()
 
+	N.B. the rise and set are from the accurate apparent Sun of VSOP87Ephemeris{}, as for GetSunriseSunsetTimesForEphemeris().
+
 	@param datetime - the datetime of the observer (in localtime)
 	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
 	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
@@ -123,7 +149,106 @@ func GetSolarHourAngle(δ float64, degreesBelowHorizon float64, latitude float64
 	@returns the rise, noon and set for the Sun, in localtime
 */
 func GetSunriseSunsetTimes(datetime time.Time, degreesBelowHorizon float64, longitude float64, latitude float64, elevation float64) (Sun, error) {
-	sun := GetSunriseSunsetTimesInUTC(datetime, degreesBelowHorizon, longitude, latitude, elevation)
+	return GetSunriseSunsetTimesForEphemeris(datetime, degreesBelowHorizon, longitude, latitude, elevation, VSOP87Ephemeris{})
+}
+
+/*
+	GetSunriseSunsetTimesInUTC()
+
+	N.B. the rise and set are from the accurate apparent Sun of VSOP87Ephemeris{}, as for GetSunriseSunsetTimesInUTCForEphemeris(),
+	and so are the zero time if the Sun does not reach the altitude on the day, e.g., during the polar day or night.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param elevation - is the elevation (above sea level) in meters of some observer on Earth
+	@returns the rise, noon and set for the Sun, in UTC (*not local time)
+*/
+func GetSunriseSunsetTimesInUTC(datetime time.Time, degreesBelowHorizon float64, longitude float64, latitude float64, elevation float64) Sun {
+	// the VSOP87 ephemeris gives the position of the Sun for any datetime, and so never returns an error:
+	sun, _ := GetSunriseSunsetTimesInUTCForEphemeris(datetime, degreesBelowHorizon, longitude, latitude, elevation, VSOP87Ephemeris{})
+
+	return sun
+}
+
+/*
+	getSolarHourAnglesForEphemeris()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param h0 - the standard altitude of the Sun (in degrees)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param ephemeris - the ephemeris backend, e.g., MeeusEphemeris{}
+	@returns the hour angle of the Sun between [-180°, 180°), and the hour angle at which the Sun reaches the standard altitude
+	(or NaN if it never does), in degrees
+	@see eq.15.1 p.102 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func getSolarHourAnglesForEphemeris(datetime time.Time, h0 float64, longitude float64, latitude float64, ephemeris Ephemeris) (float64, float64, error) {
+	eq, err := GetEquatorialPosition(datetime, BodySun, ephemeris)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var H = getApparentHourAngle(datetime, longitude, eq.RightAscension)
+
+	var cosH0 = (sinx(h0) - sinx(latitude)*sinx(eq.Declination)) / (cosx(latitude) * cosx(eq.Declination))
+
+	if cosH0 < -1 || cosH0 > 1 {
+		return H, math.NaN(), nil
+	}
+
+	return H, acosx(cosH0), nil
+}
+
+/*
+	getSolarEventForEphemeris()
+
+	@param datetime - the first estimate of the datetime of the event (in UTC)
+	@param sign - the sign of the hour angle of the event, i.e., -1 for the rise and +1 for the set
+	@param h0 - the standard altitude of the Sun (in degrees)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param ephemeris - the ephemeris backend, e.g., MeeusEphemeris{}
+	@returns the refined datetime of the event (in UTC), or the zero time if the Sun does not reach the standard altitude
+*/
+func getSolarEventForEphemeris(datetime time.Time, sign float64, h0 float64, longitude float64, latitude float64, ephemeris Ephemeris) (time.Time, error) {
+	for i := 0; i < 4; i++ {
+		H, H0, err := getSolarHourAnglesForEphemeris(datetime, h0, longitude, latitude, ephemeris)
+
+		if err != nil {
+			return datetime, err
+		}
+
+		if math.IsNaN(H0) {
+			return time.Time{}, nil
+		}
+
+		// the hour angle of the Sun advances by ~15° per hour:
+		var Δ = math.Mod(sign*H0-H+540, 360) - 180
+
+		datetime = datetime.Add(time.Duration(Δ / 15 * float64(time.Hour)))
+	}
+
+	return datetime, nil
+}
+
+/*
+	GetSunriseSunsetTimesForEphemeris()
+
+	@param datetime - the datetime of the observer (in localtime)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param elevation - is the elevation (above sea level) in meters of some observer on Earth
+	@param ephemeris - the ephemeris backend, e.g., MeeusEphemeris{}
+	@returns the rise, noon and set for the Sun, in localtime, or an error
+*/
+func GetSunriseSunsetTimesForEphemeris(datetime time.Time, degreesBelowHorizon float64, longitude float64, latitude float64, elevation float64, ephemeris Ephemeris) (Sun, error) {
+	sun, err := GetSunriseSunsetTimesInUTCForEphemeris(datetime, degreesBelowHorizon, longitude, latitude, elevation, ephemeris)
+
+	if err != nil {
+		return sun, err
+	}
 
 	// get the corresponding timezone for the longitude and latitude provided:
 	timezone := tzm.LatLngToTimezoneString(latitude, longitude)
@@ -142,42 +267,64 @@ func GetSunriseSunsetTimes(datetime time.Time, degreesBelowHorizon float64, long
 }
 
 /*
-	GetSunriseSunsetTimesInUTC()
+	GetSunriseSunsetTimesInUTCForEphemeris()
+
+	N.B. the rise and set are the zero time if the Sun does not reach the altitude on the day, e.g., during the polar day or night.
 
 	@param datetime - the datetime of the observer (in UTC)
 	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
 	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
 	@param elevation - is the elevation (above sea level) in meters of some observer on Earth
-	@returns the rise, noon and set for the Sun, in UTC (*not local time)
+	@param ephemeris - the ephemeris backend, e.g., MeeusEphemeris{}
+	@returns the rise, noon and set for the Sun, in UTC (*not local time), or an error
+	@see ch.15 p.101 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
 */
-func GetSunriseSunsetTimesInUTC(datetime time.Time, degreesBelowHorizon float64, longitude float64, latitude float64, elevation float64) Sun {
-	var J float64 = GetMeanSolarTime(datetime, longitude)
+func GetSunriseSunsetTimesInUTCForEphemeris(datetime time.Time, degreesBelowHorizon float64, longitude float64, latitude float64, elevation float64, ephemeris Ephemeris) (Sun, error) {
+	// the standard altitude of the Sun, corrected for refraction, the semi-diameter and the dip of the horizon:
+	var h0 = getSolarStandardAltitude(degreesBelowHorizon, elevation)
 
-	var M float64 = GetSolarMeanAnomaly(J)
+	// the first estimate of the local solar transit, i.e., noon of the local mean solar time:
+	var noon = GetDatetimeZeroHour(datetime).Add(time.Duration((12 - longitude/15) * float64(time.Hour)))
 
-	var C float64 = GetSolarEquationOfCenter(M)
+	for i := 0; i < 3; i++ {
+		H, _, err := getSolarHourAnglesForEphemeris(noon, h0, longitude, latitude, ephemeris)
 
-	var λ float64 = GetSolarEclipticLongitude(M, C)
+		if err != nil {
+			return Sun{}, err
+		}
 
-	var δ float64 = GetSolarDeclination(λ)
+		noon = noon.Add(time.Duration(-H / 15 * float64(time.Hour)))
+	}
+
+	_, H0, err := getSolarHourAnglesForEphemeris(noon, h0, longitude, latitude, ephemeris)
 
-	var ω float64 = GetSolarHourAngle(δ, degreesBelowHorizon, latitude, elevation)
+	if err != nil {
+		return Sun{}, err
+	}
 
-	var h float64 = ω / 360
+	if math.IsNaN(H0) {
+		return Sun{
+			Noon: noon,
+		}, nil
+	}
 
-	var J_transit float64 = GetSolarTransitJulianDate(J, M, λ)
+	rise, err := getSolarEventForEphemeris(noon.Add(time.Duration(-H0/15*float64(time.Hour))), -1, h0, longitude, latitude, ephemeris)
 
-	var J_rise = J_transit - h
+	if err != nil {
+		return Sun{}, err
+	}
 
-	var J_set = J_transit + h
+	set, err := getSolarEventForEphemeris(noon.Add(time.Duration(H0/15*float64(time.Hour))), 1, h0, longitude, latitude, ephemeris)
 
-	sun := Sun{
-		Rise: GetUniversalTime(J_rise),
-		Noon: GetUniversalTime(J_transit),
-		Set:  GetUniversalTime(J_set),
+	if err != nil {
+		return Sun{}, err
 	}
 
-	return sun
+	return Sun{
+		Rise: rise,
+		Noon: noon,
+		Set:  set,
+	}, nil
 }
 
 /*
@@ -206,7 +353,7 @@ func GetSolarEclipticPosition(datetime time.Time) EclipticCoordinate {
 	@returns the Solar equatorial position (right ascension & declination) in degrees:
 */
 func GetSolarEquatorialPosition(datetime time.Time) EquatorialCoordinate {
-	var T = GetCurrentJulianCenturyRelativeToJ2000(datetime)
+	var T = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
 
 	var ε = GetObliquityOfTheEclipticLawrence(T)
 
@@ -228,3 +375,281 @@ func GetSolarEquatorialPosition(datetime time.Time) EquatorialCoordinate {
 		Declination:    dec,
 	}
 }
+
+/*
+	GetApparentSolarEclipticPosition()
+
+	N.B. the geometric position of the Sun is that of the Earth from the truncated VSOP87 theory, reduced to the FK5
+	system and corrected for nutation and aberration, i.e., accurate to about one arcsecond.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the apparent geocentric ecliptic coordinate (λ, β, Δ in km) of the Sun, referred to the true equinox of date
+	@see ch.25 p.154 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetApparentSolarEclipticPosition(datetime time.Time) EclipticCoordinate {
+	var T = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
+
+	var earth = GetEarthHeliocentricEclipticPosition(datetime)
+
+	// the geometric longitude and latitude of the Sun, as seen from the Earth:
+	var Θ = earth.Longitude + 180
+
+	var β = -earth.Latitude
+
+	// the conversion to the FK5 system (eq.25.9):
+	var λ = Θ - 1.397*T - 0.00031*math.Pow(T, 2)
+
+	Θ += -0.09033 / 3600
+
+	β += 0.03916 * (cosx(λ) - sinx(λ)) / 3600
+
+	// the radius vector of the Earth, in AU:
+	var R = earth.Δ / ASTRONOMICAL_UNIT_IN_KM
+
+	var Δψ = GetNutationInLongitudeOfTheEcliptic(GetSolarMeanLongitude(T), GetLunarMeanLongitude(T), GetLunarLongitudeOfTheAscendingNode(T))
+
+	// the correction for nutation, and for aberration (in degrees):
+	λ = Θ + Δψ - 20.4898/3600/R
+
+	// applies modulo correction to the angle, and ensures always positive:
+	λ = math.Mod(λ, 360)
+
+	// correct for negative angles
+	if λ < 0 {
+		λ += 360
+	}
+
+	return EclipticCoordinate{
+		Longitude: λ,
+		Latitude:  β,
+		Δ:         earth.Δ,
+	}
+}
+
+/*
+	GetApparentSolarEquatorialPosition()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the apparent geocentric equatorial coordinate { ra, dec } of the Sun, referred to the true equinox of date
+	@see ch.25 p.154 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetApparentSolarEquatorialPosition(datetime time.Time) EquatorialCoordinate {
+	var eq = ConvertEclipticCoordinateToEquatorial(datetime, GetApparentSolarEclipticPosition(datetime))
+
+	// correct for negative angles
+	if eq.RightAscension < 0 {
+		eq.RightAscension += 360
+	}
+
+	return eq
+}
+
+/*
+	GetSolarDistance()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the distance between the centres of the Earth and the Sun (in AU)
+*/
+func GetSolarDistance(datetime time.Time) float64 {
+	return GetEarthHeliocentricEclipticPosition(datetime).Δ / ASTRONOMICAL_UNIT_IN_KM
+}
+
+/*
+	GetSolarAngularDiameter()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the apparent angular diameter of the Sun (in degrees), i.e., twice the semidiameter of 959.63" at 1 AU
+	@see ch.55 p.359 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetSolarAngularDiameter(datetime time.Time) float64 {
+	return 2 * 959.63 / GetSolarDistance(datetime) / 3600
+}
+
+/*
+	GetSolarHorizontalPosition()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@returns the geometric horizontal coordinate of the apparent Sun, with the azimuth measured eastwards from north
+*/
+func GetSolarHorizontalPosition(datetime time.Time, longitude float64, latitude float64) HorizontalCoordinate {
+	hz, _ := GetSolarHorizontalPositionForEphemeris(datetime, longitude, latitude, VSOP87Ephemeris{})
+
+	return hz
+}
+
+/*
+	GetSolarHorizontalPositionForEphemeris()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param ephemeris - the ephemeris backend, e.g., VSOP87Ephemeris{}
+	@returns the geometric horizontal coordinate of the Sun, with the azimuth measured eastwards from north, or an error
+*/
+func GetSolarHorizontalPositionForEphemeris(datetime time.Time, longitude float64, latitude float64, ephemeris Ephemeris) (HorizontalCoordinate, error) {
+	eq, err := GetEquatorialPosition(datetime, BodySun, ephemeris)
+
+	if err != nil {
+		return HorizontalCoordinate{}, err
+	}
+
+	var H = getApparentHourAngle(datetime, longitude, eq.RightAscension)
+
+	return getHorizontalCoordinateFromHourAngle(H, eq.Declination, latitude), nil
+}
+
+type SunAzimuths struct {
+	RiseAzimuth  float64
+	NoonAltitude float64
+	SetAzimuth   float64
+}
+
+/*
+	GetSunriseSunsetAzimuths()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param degreesBelowHorizon - is the degrees below horizon, as for GetSunriseSunsetTimes()
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param elevation - is the elevation (above sea level) in meters of some observer on Earth
+	@returns the azimuths (eastwards from north) of the Sun at its rise and set, and its altitude at noon (in degrees)
+*/
+func GetSunriseSunsetAzimuths(datetime time.Time, degreesBelowHorizon float64, longitude float64, latitude float64, elevation float64) SunAzimuths {
+	azimuths, _ := GetSunriseSunsetAzimuthsForEphemeris(datetime, degreesBelowHorizon, longitude, latitude, elevation, VSOP87Ephemeris{})
+
+	return azimuths
+}
+
+/*
+	GetSunriseSunsetAzimuthsForEphemeris()
+
+	N.B. the rise and set azimuths are NaN if the Sun does not reach the altitude on the day, e.g., during the polar day or night.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param degreesBelowHorizon - is the degrees below horizon, as for GetSunriseSunsetTimes()
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param elevation - is the elevation (above sea level) in meters of some observer on Earth
+	@param ephemeris - the ephemeris backend, e.g., VSOP87Ephemeris{}
+	@returns the azimuths (eastwards from north) of the Sun at its rise and set, and its altitude at noon (in degrees), or an error
+*/
+func GetSunriseSunsetAzimuthsForEphemeris(datetime time.Time, degreesBelowHorizon float64, longitude float64, latitude float64, elevation float64, ephemeris Ephemeris) (SunAzimuths, error) {
+	sun, err := GetSunriseSunsetTimesInUTCForEphemeris(datetime, degreesBelowHorizon, longitude, latitude, elevation, ephemeris)
+
+	if err != nil {
+		return SunAzimuths{}, err
+	}
+
+	var azimuths = SunAzimuths{
+		RiseAzimuth: math.NaN(),
+		SetAzimuth:  math.NaN(),
+	}
+
+	noon, err := GetSolarHorizontalPositionForEphemeris(sun.Noon, longitude, latitude, ephemeris)
+
+	if err != nil {
+		return SunAzimuths{}, err
+	}
+
+	azimuths.NoonAltitude = noon.Altitude
+
+	if !sun.Rise.IsZero() {
+		rise, err := GetSolarHorizontalPositionForEphemeris(sun.Rise, longitude, latitude, ephemeris)
+
+		if err != nil {
+			return SunAzimuths{}, err
+		}
+
+		azimuths.RiseAzimuth = rise.Azimuth
+	}
+
+	if !sun.Set.IsZero() {
+		set, err := GetSolarHorizontalPositionForEphemeris(sun.Set, longitude, latitude, ephemeris)
+
+		if err != nil {
+			return SunAzimuths{}, err
+		}
+
+		azimuths.SetAzimuth = set.Azimuth
+	}
+
+	return azimuths, nil
+}
+
+type SunAzimuthExtreme struct {
+	Datetime time.Time
+	Azimuth  float64
+}
+
+type SunAzimuthExtremes struct {
+	NorthernmostRise SunAzimuthExtreme
+	SouthernmostRise SunAzimuthExtreme
+	NorthernmostSet  SunAzimuthExtreme
+	SouthernmostSet  SunAzimuthExtreme
+}
+
+/*
+	GetSunriseSunsetAzimuthExtremes()
+
+	N.B. the extremes are found from the rise and set of each day of the year, and so are the zero value if the Sun does
+	not rise or set on any day, e.g., at the poles.
+
+	@param datetime - the datetime of the observer (in UTC), whose year is used
+	@param degreesBelowHorizon - is the degrees below horizon, as for GetSunriseSunsetTimes()
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param elevation - is the elevation (above sea level) in meters of some observer on Earth
+	@returns the northernmost and southernmost azimuths (eastwards from north) of the rise and set of the Sun in the year,
+	and the datetimes (in UTC) at which they occur
+*/
+func GetSunriseSunsetAzimuthExtremes(datetime time.Time, degreesBelowHorizon float64, longitude float64, latitude float64, elevation float64) (SunAzimuthExtremes, error) {
+	var extremes = SunAzimuthExtremes{}
+
+	var ephemeris = VSOP87Ephemeris{}
+
+	for d := time.Date(datetime.Year(), 1, 1, 0, 0, 0, 0, time.UTC); d.Year() == datetime.Year(); d = d.AddDate(0, 0, 1) {
+		sun, err := GetSunriseSunsetTimesInUTCForEphemeris(d, degreesBelowHorizon, longitude, latitude, elevation, ephemeris)
+
+		if err != nil {
+			return extremes, err
+		}
+
+		if !sun.Rise.IsZero() {
+			rise, err := GetSolarHorizontalPositionForEphemeris(sun.Rise, longitude, latitude, ephemeris)
+
+			if err != nil {
+				return extremes, err
+			}
+
+			// the rise is towards the east, and so the northernmost rise has the least azimuth:
+			if extremes.NorthernmostRise.Datetime.IsZero() || rise.Azimuth < extremes.NorthernmostRise.Azimuth {
+				extremes.NorthernmostRise = SunAzimuthExtreme{Datetime: sun.Rise, Azimuth: rise.Azimuth}
+			}
+
+			if extremes.SouthernmostRise.Datetime.IsZero() || rise.Azimuth > extremes.SouthernmostRise.Azimuth {
+				extremes.SouthernmostRise = SunAzimuthExtreme{Datetime: sun.Rise, Azimuth: rise.Azimuth}
+			}
+		}
+
+		if !sun.Set.IsZero() {
+			set, err := GetSolarHorizontalPositionForEphemeris(sun.Set, longitude, latitude, ephemeris)
+
+			if err != nil {
+				return extremes, err
+			}
+
+			// the set is towards the west, and so the northernmost set has the greatest azimuth:
+			if extremes.NorthernmostSet.Datetime.IsZero() || set.Azimuth > extremes.NorthernmostSet.Azimuth {
+				extremes.NorthernmostSet = SunAzimuthExtreme{Datetime: sun.Set, Azimuth: set.Azimuth}
+			}
+
+			if extremes.SouthernmostSet.Datetime.IsZero() || set.Azimuth < extremes.SouthernmostSet.Azimuth {
+				extremes.SouthernmostSet = SunAzimuthExtreme{Datetime: sun.Set, Azimuth: set.Azimuth}
+			}
+		}
+	}
+
+	return extremes, nil
+}
@@ -138,7 +138,7 @@ func TestGetSunriseSunsetTimesRise(t *testing.T) {
 
 	var got time.Time = sun.Rise
 
-	var want = time.Date(1992, 4, 12, 6, 05, 49, 72323712, timezone)
+	var want = time.Date(1992, 4, 12, 6, 5, 58, 815862677, timezone)
 
 	if got.String() != want.String() {
 		t.Errorf("got %q, wanted %q", got, want)
@@ -157,7 +157,7 @@ func TestGetSunriseSunsetTimesNoon(t *testing.T) {
 
 	var got time.Time = sun.Noon
 
-	var want = time.Date(1992, 4, 12, 12, 22, 10, 770278016, timezone)
+	var want = time.Date(1992, 4, 12, 12, 22, 28, 783970701, timezone)
 
 	if got.String() != want.String() {
 		t.Errorf("got %q, wanted %q", got, want)
@@ -176,7 +176,7 @@ func TestGetSunriseSunsetTimesSet(t *testing.T) {
 
 	var got time.Time = sun.Set
 
-	var want = time.Date(1992, 4, 12, 18, 38, 32, 468232192, timezone)
+	var want = time.Date(1992, 4, 12, 18, 39, 15, 729626956, timezone)
 
 	if got.String() != want.String() {
 		t.Errorf("got %q, wanted %q", got, want)
@@ -195,7 +195,7 @@ func TestGetSunriseSunsetTimesInUTCRise(t *testing.T) {
 
 	var got time.Time = sun.Rise.In(timezone)
 
-	var want = time.Date(1992, 4, 12, 6, 05, 49, 72323712, timezone)
+	var want = time.Date(1992, 4, 12, 6, 5, 58, 815862677, timezone)
 
 	if got != want {
 		t.Errorf("got %q, wanted %q", got, want)
@@ -214,7 +214,7 @@ func TestGetSunriseSunsetTimesInUTCRiseWithOffsetHorizon(t *testing.T) {
 
 	var got time.Time = sun.Rise.In(timezone)
 
-	var want = time.Date(1992, 4, 12, 6, 05, 49, 72323712, timezone)
+	var want = time.Date(1992, 4, 12, 6, 5, 58, 815862677, timezone)
 
 	if got.After(want) {
 		t.Errorf("got %q, wanted %q", got, want)
@@ -233,7 +233,7 @@ func TestGetSunriseSunsetTimesInUTCNoon(t *testing.T) {
 
 	var got time.Time = sun.Noon.In(timezone)
 
-	var want = time.Date(1992, 4, 12, 12, 22, 10, 770278016, timezone)
+	var want = time.Date(1992, 4, 12, 12, 22, 28, 783970701, timezone)
 
 	if got != want {
 		t.Errorf("got %q, wanted %q", got, want)
@@ -252,7 +252,7 @@ func TestGetSunriseSunsetTimesInUTCSet(t *testing.T) {
 
 	var got time.Time = sun.Set.In(timezone)
 
-	var want = time.Date(1992, 4, 12, 18, 38, 32, 468232192, timezone)
+	var want = time.Date(1992, 4, 12, 18, 39, 15, 729626956, timezone)
 
 	if got != want {
 		t.Errorf("got %q, wanted %q", got, want)
@@ -271,7 +271,7 @@ func TestGetSunriseSunsetTimesInUTCSetWithOffsetHorizon(t *testing.T) {
 
 	var got time.Time = sun.Set.In(timezone)
 
-	var want = time.Date(1992, 4, 12, 18, 38, 32, 468232192, timezone)
+	var want = time.Date(1992, 4, 12, 18, 39, 15, 729626956, timezone)
 
 	if got.Before(want) {
 		t.Errorf("got %q, wanted %q", got, want)
@@ -279,8 +279,8 @@ func TestGetSunriseSunsetTimesInUTCSetWithOffsetHorizon(t *testing.T) {
 }
 
 func TestGetSolarEclipticPositionLongitude(t *testing.T) {
-	// Date of observation:
-	var datetime time.Time = time.Date(2015, 2, 5, 17, 0, 0, 0, time.UTC)
+	// Date of observation (as a reading of Terrestrial Time, TT):
+	var datetime time.Time = ConvertTTToUTC(time.Date(2015, 2, 5, 17, 0, 0, 0, time.UTC))
 
 	var ec = GetSolarEclipticPosition(datetime)
 
@@ -337,3 +337,272 @@ func TestGetSolarEquatorialPositionDeclination(t *testing.T) {
 		t.Errorf("got %f, wanted %f", got, want)
 	}
 }
+
+func TestGetSunriseSunsetTimesInUTCForEphemeris(t *testing.T) {
+	var want Sun = GetSunriseSunsetTimesInUTC(d, 0, longitude, latitude, elevation)
+
+	for _, ephemeris := range []Ephemeris{LawrenceEphemeris{}, MeeusEphemeris{}, VSOP87Ephemeris{}} {
+		got, err := GetSunriseSunsetTimesInUTCForEphemeris(d, 0, longitude, latitude, elevation, ephemeris)
+
+		if err != nil {
+			t.Errorf("got %q", err)
+		}
+
+		// the ephemerides agree with the apparent Sun of VSOP87 to within a minute:
+		if math.Abs(got.Rise.Sub(want.Rise).Minutes()) > 1 {
+			t.Errorf("got %q, wanted %q", got.Rise, want.Rise)
+		}
+
+		if math.Abs(got.Noon.Sub(want.Noon).Minutes()) > 1 {
+			t.Errorf("got %q, wanted %q", got.Noon, want.Noon)
+		}
+
+		if math.Abs(got.Set.Sub(want.Set).Minutes()) > 1 {
+			t.Errorf("got %q, wanted %q", got.Set, want.Set)
+		}
+	}
+}
+
+func TestGetSunriseSunsetTimesInUTCForEphemerisPolarNight(t *testing.T) {
+	// the Sun does not rise at Longyearbyen, Svalbard during the winter solstice:
+	got, err := GetSunriseSunsetTimesInUTCForEphemeris(time.Date(2021, 12, 21, 0, 0, 0, 0, time.UTC), 0, 15.6267, 78.2232, 0, MeeusEphemeris{})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if !got.Rise.IsZero() || !got.Set.IsZero() || got.Noon.IsZero() {
+		t.Errorf("got %v, wanted only a solar transit", got)
+	}
+}
+
+func TestGetSunriseSunsetTimesForEphemeris(t *testing.T) {
+	got, err := GetSunriseSunsetTimesForEphemeris(d, 0, longitude, latitude, elevation, MeeusEphemeris{})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if got.Rise.Location().String() != "Pacific/Honolulu" {
+		t.Errorf("got %q, wanted %q", got.Rise.Location().String(), "Pacific/Honolulu")
+	}
+
+	if got.Rise.Hour() != 6 || got.Rise.Minute() < 4 || got.Rise.Minute() > 7 {
+		t.Errorf("got %q, wanted approximately 06:05 HST", got.Rise)
+	}
+}
+
+func TestGetEarthHeliocentricEclipticPosition(t *testing.T) {
+	// see ex.25.b p.156 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	var got = GetEarthHeliocentricEclipticPosition(ConvertTTToUTC(time.Date(1992, 10, 13, 0, 0, 0, 0, time.UTC)))
+
+	if math.Abs(got.Longitude-19.907372) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got.Longitude, 19.907372)
+	}
+
+	if math.Abs(got.Latitude*3600+0.644) > 0.01 {
+		t.Errorf("got %f, wanted %f", got.Latitude*3600, -0.644)
+	}
+
+	if math.Abs(got.Δ/ASTRONOMICAL_UNIT_IN_KM-0.99760775) > 0.00000001 {
+		t.Errorf("got %f, wanted %f", got.Δ/ASTRONOMICAL_UNIT_IN_KM, 0.99760775)
+	}
+}
+
+func TestGetApparentSolarEclipticPosition(t *testing.T) {
+	// see ex.25.b p.156 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	var got = GetApparentSolarEclipticPosition(ConvertTTToUTC(time.Date(1992, 10, 13, 0, 0, 0, 0, time.UTC)))
+
+	// 199°54'21.818":
+	if math.Abs(got.Longitude-199.906060) > 0.0003 {
+		t.Errorf("got %f, wanted %f", got.Longitude, 199.906060)
+	}
+
+	if math.Abs(got.Latitude*3600-0.62) > 0.01 {
+		t.Errorf("got %f, wanted %f", got.Latitude*3600, 0.62)
+	}
+}
+
+func TestGetApparentSolarEquatorialPosition(t *testing.T) {
+	// see ex.25.b p.156 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	var got = GetApparentSolarEquatorialPosition(ConvertTTToUTC(time.Date(1992, 10, 13, 0, 0, 0, 0, time.UTC)))
+
+	// 13h13m30.749s:
+	if math.Abs(got.RightAscension-198.378121) > 0.0003 {
+		t.Errorf("got %f, wanted %f", got.RightAscension, 198.378121)
+	}
+
+	// -7°47'01.74":
+	if math.Abs(got.Declination+7.783817) > 0.0003 {
+		t.Errorf("got %f, wanted %f", got.Declination, -7.783817)
+	}
+}
+
+func TestGetSolarDistance(t *testing.T) {
+	// the Earth is at perihelion on 2021 January 2, at 0.9832570 AU:
+	var got = GetSolarDistance(time.Date(2021, 1, 2, 13, 51, 0, 0, time.UTC))
+
+	if math.Abs(got-0.9832570) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got, 0.9832570)
+	}
+}
+
+func TestGetSolarAngularDiameter(t *testing.T) {
+	// the Sun subtends 32'32" at perihelion, and 31'28" at aphelion:
+	var perihelion = GetSolarAngularDiameter(time.Date(2021, 1, 2, 13, 51, 0, 0, time.UTC))
+
+	if math.Abs(perihelion*60-32.53) > 0.01 {
+		t.Errorf("got %f, wanted %f", perihelion*60, 32.53)
+	}
+
+	var aphelion = GetSolarAngularDiameter(time.Date(2021, 7, 5, 22, 27, 0, 0, time.UTC))
+
+	if math.Abs(aphelion*60-31.46) > 0.01 {
+		t.Errorf("got %f, wanted %f", aphelion*60, 31.46)
+	}
+}
+
+func TestGetSolarHorizontalPosition(t *testing.T) {
+	// the Sun is due east in the morning, and due west in the evening, at the equator on the equinox:
+	var morning = GetSolarHorizontalPosition(time.Date(2021, 3, 20, 9, 37, 0, 0, time.UTC), -90, 0)
+
+	if math.Abs(morning.Azimuth-90) > 1 {
+		t.Errorf("got %v, wanted an azimuth of %f", morning, 90.0)
+	}
+
+	var evening = GetSolarHorizontalPosition(time.Date(2021, 3, 20, 21, 37, 0, 0, time.UTC), -90, 0)
+
+	if math.Abs(evening.Azimuth-270) > 1 {
+		t.Errorf("got %v, wanted an azimuth of %f", evening, 270.0)
+	}
+
+	// the geocentric position agrees with the observer hour angle (11.105900°) and declination (-9.314340°) of the NREL
+	// reference case, to which the mean sidereal time would be in error by the nutation in right ascension (~0.004°):
+	var want = getHorizontalCoordinateFromHourAngle(11.105900, -9.314340, spaParameters.Latitude)
+
+	var got = GetSolarHorizontalPosition(spaDatetime, spaParameters.Longitude, spaParameters.Latitude)
+
+	if math.Abs(got.Altitude-want.Altitude) > 0.0005 {
+		t.Errorf("got %f, wanted %f", got.Altitude, want.Altitude)
+	}
+
+	if math.Abs(got.Azimuth-want.Azimuth) > 0.0005 {
+		t.Errorf("got %f, wanted %f", got.Azimuth, want.Azimuth)
+	}
+}
+
+func TestGetSunriseSunsetAzimuths(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC)
+
+	var got = GetSunriseSunsetAzimuths(datetime, 0, longitude, latitude, elevation)
+
+	sun, err := GetSunriseSunsetTimesInUTCForEphemeris(datetime, 0, longitude, latitude, elevation, VSOP87Ephemeris{})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	// the azimuth of the Sun at the standard altitude h0 = -0.83°, from its declination (eq.13.5 & eq.13.6):
+	var δ = GetApparentSolarEquatorialPosition(sun.Rise).Declination
+
+	var want = acosx((sinx(δ) - sinx(-0.83)*sinx(latitude)) / (cosx(-0.83) * cosx(latitude)))
+
+	if math.Abs(got.RiseAzimuth-want) > 0.01 {
+		t.Errorf("got %f, wanted %f", got.RiseAzimuth, want)
+	}
+
+	// the set is approximately the reflection of the rise about the meridian:
+	if math.Abs(got.SetAzimuth-(360-got.RiseAzimuth)) > 0.5 {
+		t.Errorf("got %f, wanted %f", got.SetAzimuth, 360-got.RiseAzimuth)
+	}
+
+	// the Sun transits ~1° south of the zenith in mid-May at Hawaii:
+	δ = GetApparentSolarEquatorialPosition(sun.Noon).Declination
+
+	if math.Abs(got.NoonAltitude-(90-math.Abs(latitude-δ))) > 0.01 {
+		t.Errorf("got %f, wanted %f", got.NoonAltitude, 90-math.Abs(latitude-δ))
+	}
+}
+
+func TestGetSunriseSunsetAzimuthsPolarNight(t *testing.T) {
+	// the Sun does not rise at Longyearbyen, Svalbard during the winter solstice:
+	var got = GetSunriseSunsetAzimuths(time.Date(2021, 12, 21, 0, 0, 0, 0, time.UTC), 0, 15.6267, 78.2232, 0)
+
+	if !math.IsNaN(got.RiseAzimuth) || !math.IsNaN(got.SetAzimuth) || got.NoonAltitude > 0 {
+		t.Errorf("got %v, wanted no rise or set", got)
+	}
+}
+
+func TestGetSunriseSunsetAzimuthExtremes(t *testing.T) {
+	// London, UK:
+	got, err := GetSunriseSunsetAzimuthExtremes(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 0, -0.1278, 51.5074, 0)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	// the northernmost rise and set are at the June solstice, and the southernmost at the December solstice:
+	var june = time.Date(2021, 6, 21, 0, 0, 0, 0, time.UTC)
+
+	var december = time.Date(2021, 12, 21, 0, 0, 0, 0, time.UTC)
+
+	for _, extreme := range []struct {
+		got  SunAzimuthExtreme
+		when time.Time
+		want float64
+	}{
+		{got.NorthernmostRise, june, 48.9},
+		{got.SouthernmostRise, december, 128.4},
+		{got.NorthernmostSet, june, 311.1},
+		{got.SouthernmostSet, december, 231.6},
+	} {
+		if math.Abs(extreme.got.Datetime.Sub(extreme.when).Hours()) > 24*7 {
+			t.Errorf("got %q, wanted %q", extreme.got.Datetime, extreme.when)
+		}
+
+		if math.Abs(extreme.got.Azimuth-extreme.want) > 0.5 {
+			t.Errorf("got %f, wanted %f", extreme.got.Azimuth, extreme.want)
+		}
+	}
+}
+
+func TestGetSunriseSunsetTimesInUTCElevation(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC)
+
+	var legacy = [2]Sun{GetSunriseSunsetTimesInUTC(datetime, 0, longitude, latitude, 0), GetSunriseSunsetTimesInUTC(datetime, 0, longitude, latitude, 1000)}
+
+	var ephemeris [2]Sun
+
+	for i, h := range []float64{0, 1000} {
+		sun, err := GetSunriseSunsetTimesInUTCForEphemeris(datetime, 0, longitude, latitude, h, LawrenceEphemeris{})
+
+		if err != nil {
+			t.Errorf("got %q", err)
+		}
+
+		ephemeris[i] = sun
+	}
+
+	// the dip of the horizon (~1.09° at 1000m) brings the sunrise earlier, and the sunset later, by ~4.5 minutes by both routes:
+	for _, sun := range [][2]Sun{legacy, ephemeris} {
+		var rise, set = sun[1].Rise.Sub(sun[0].Rise).Minutes(), sun[1].Set.Sub(sun[0].Set).Minutes()
+
+		if rise > -4 || rise < -5.5 || set < 4 || set > 5.5 {
+			t.Errorf("got %f and %f minutes, wanted about -4.5 and +4.5 minutes", rise, set)
+		}
+	}
+}
+
+func TestGetSolarNoonAzimuthConsistency(t *testing.T) {
+	// the hour angles of the transit and of the horizontal position are both from the apparent sidereal time, and so the
+	// Sun is due south at the noon of the transit, rather than displaced by the nutation in right ascension (~0.003°):
+	sun, err := GetSunriseSunsetTimesInUTCForEphemeris(time.Date(2021, 12, 21, 0, 0, 0, 0, time.UTC), 0, -0.1278, 51.5074, 0, VSOP87Ephemeris{})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if got := GetSolarHorizontalPosition(sun.Noon, -0.1278, 51.5074); math.Abs(got.Azimuth-180) > 0.0001 {
+		t.Errorf("got %f, wanted %f", got.Azimuth, 180.0)
+	}
+}
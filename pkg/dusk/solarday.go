@@ -0,0 +1,321 @@
+package dusk
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	tzm "github.com/zsefvlol/timezonemapper"
+)
+
+type SolarPhase int
+
+const (
+	SolarPhaseNight = SolarPhase(iota)
+	SolarPhaseAstronomicalTwilight
+	SolarPhaseNauticalTwilight
+	SolarPhaseCivilTwilight
+	SolarPhaseBlueHour
+	SolarPhaseGoldenHour
+	SolarPhaseSunrise
+	SolarPhaseNoon
+	SolarPhaseSunset
+	SolarPhaseDay
+)
+
+var solarPhaseNames = [...]string{
+	SolarPhaseNight:                "Night",
+	SolarPhaseAstronomicalTwilight: "Astronomical Twilight",
+	SolarPhaseNauticalTwilight:     "Nautical Twilight",
+	SolarPhaseCivilTwilight:        "Civil Twilight",
+	SolarPhaseBlueHour:             "Blue Hour",
+	SolarPhaseGoldenHour:           "Golden Hour",
+	SolarPhaseSunrise:              "Sunrise",
+	SolarPhaseNoon:                 "Solar Noon",
+	SolarPhaseSunset:               "Sunset",
+	SolarPhaseDay:                  "Day",
+}
+
+/*
+	the altitude of the Sun (in degrees) at which the golden hour ends in the morning, and begins in the evening
+*/
+var GOLDEN_HOUR_ALTITUDE float64 = 6
+
+/*
+	the altitude of the Sun (in degrees) between the blue hour and the golden hour
+*/
+var BLUE_HOUR_ALTITUDE float64 = -4
+
+/*
+	String()
+
+	@returns the English name of the phase of the solar day, e.g., "Golden Hour"
+*/
+func (p SolarPhase) String() string {
+	if p < SolarPhaseNight || p > SolarPhaseDay {
+		return "Unknown"
+	}
+
+	return solarPhaseNames[p]
+}
+
+type SolarInterval struct {
+	Phase SolarPhase
+	// whether the interval begins before the solar transit:
+	Morning  bool
+	From     time.Time
+	Until    time.Time
+	Duration time.Duration
+}
+
+/*
+	getSolarIntervalsForEphemeris()
+
+	@param noon - the datetime of the solar transit (in UTC)
+	@param phase - the phase of the solar day, e.g., SolarPhaseGoldenHour
+	@param lower - the lower altitude of the Sun (in degrees) of the phase
+	@param upper - the upper altitude of the Sun (in degrees) of the phase
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param ephemeris - the ephemeris backend, e.g., VSOP87Ephemeris{}
+	@returns the morning and evening intervals in which the altitude of the Sun is between the lower and upper altitudes,
+	i.e., a single interval across noon if the Sun does not reach the upper altitude, across midnight if it does not
+	fall to the lower altitude, or the whole day about noon if it crosses neither
+*/
+func getSolarIntervalsForEphemeris(noon time.Time, phase SolarPhase, lower float64, upper float64, longitude float64, latitude float64, ephemeris Ephemeris) ([]SolarInterval, error) {
+	var events [4]time.Time
+
+	for i, event := range []struct{ sign, altitude float64 }{{-1, lower}, {-1, upper}, {1, upper}, {1, lower}} {
+		t, err := getSolarEventForEphemeris(noon, event.sign, event.altitude, longitude, latitude, ephemeris)
+
+		if err != nil {
+			return nil, err
+		}
+
+		events[i] = t
+	}
+
+	var rise, ascended, descended, set = events[0], events[1], events[2], events[3]
+
+	switch {
+	case !rise.IsZero() && !ascended.IsZero():
+		return []SolarInterval{
+			{Phase: phase, Morning: true, From: rise, Until: ascended, Duration: ascended.Sub(rise)},
+			{Phase: phase, Morning: false, From: descended, Until: set, Duration: set.Sub(descended)},
+		}, nil
+	case !rise.IsZero():
+		// the Sun does not reach the upper altitude, and so the phase lasts across noon:
+		return []SolarInterval{
+			{Phase: phase, Morning: true, From: rise, Until: set, Duration: set.Sub(rise)},
+		}, nil
+	case !ascended.IsZero():
+		// the Sun does not fall to the lower altitude, and so the phase lasts across midnight:
+		next, err := getSolarEventForEphemeris(noon.Add(time.Hour*24), -1, upper, longitude, latitude, ephemeris)
+
+		if err != nil || next.IsZero() {
+			return nil, err
+		}
+
+		return []SolarInterval{
+			{Phase: phase, Morning: false, From: descended, Until: next, Duration: next.Sub(descended)},
+		}, nil
+	}
+
+	// the Sun crosses neither altitude, and so the phase lasts the whole day if the Sun is between them:
+	h, _, err := getSolarAltitudeForEphemeris(noon, longitude, latitude, ephemeris)
+
+	if err != nil || h < lower || h >= upper {
+		return nil, err
+	}
+
+	return []SolarInterval{
+		{Phase: phase, Morning: true, From: noon.Add(-time.Hour * 12), Until: noon.Add(time.Hour * 12), Duration: time.Hour * 24},
+	}, nil
+}
+
+/*
+	GetSolarDayTimeline()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param elevation - is the elevation (above sea level) in meters of some observer on Earth
+	@returns the labelled intervals of the solar day in chronological order (in localtime), and the local timezone
+*/
+func GetSolarDayTimeline(datetime time.Time, longitude float64, latitude float64, elevation float64) ([]SolarInterval, *time.Location, error) {
+	return GetSolarDayTimelineForEphemeris(datetime, longitude, latitude, elevation, VSOP87Ephemeris{})
+}
+
+/*
+	GetSolarDayTimelineForEphemeris()
+
+	N.B. the intervals may overlap, e.g., the golden hour spans the sunrise and the end of the morning civil twilight.
+	Phases whose altitudes the Sun does not reach on the day, e.g., the astronomical twilight during the polar day, are
+	omitted, whereas a phase within which the Sun remains all day, e.g., the civil twilight near the pole, lasts the whole
+	day about noon.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param elevation - is the elevation (above sea level) in meters of some observer on Earth
+	@param ephemeris - the ephemeris backend, e.g., VSOP87Ephemeris{}
+	@returns the labelled intervals of the solar day in chronological order (in localtime), and the local timezone
+*/
+func GetSolarDayTimelineForEphemeris(datetime time.Time, longitude float64, latitude float64, elevation float64, ephemeris Ephemeris) ([]SolarInterval, *time.Location, error) {
+	// get the corresponding timezone for the longitude and latitude provided:
+	timezone := tzm.LatLngToTimezoneString(latitude, longitude)
+
+	location, err := time.LoadLocation(timezone)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sun, err := GetSunriseSunsetTimesInUTCForEphemeris(datetime, 0, longitude, latitude, elevation, ephemeris)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the altitude of the centre of the Sun as its upper limb meets the horizon, i.e., as for GetSunriseSunsetTimesInUTCForEphemeris():
+	var h0 = getSolarStandardAltitude(0, elevation)
+
+	// the sunrise (and sunset) lasts until the lower limb of the Sun meets the horizon:
+	var h1 = h0 + GetSolarAngularDiameter(sun.Noon)
+
+	var phases = []struct {
+		phase        SolarPhase
+		lower, upper float64
+	}{
+		{SolarPhaseAstronomicalTwilight, -18, -12},
+		{SolarPhaseNauticalTwilight, -12, -6},
+		{SolarPhaseCivilTwilight, -6, h0},
+		{SolarPhaseBlueHour, -6, BLUE_HOUR_ALTITUDE},
+		{SolarPhaseGoldenHour, BLUE_HOUR_ALTITUDE, GOLDEN_HOUR_ALTITUDE},
+		{SolarPhaseSunrise, h0, h1},
+	}
+
+	var intervals = []SolarInterval{
+		{Phase: SolarPhaseNoon, Morning: false, From: sun.Noon, Until: sun.Noon},
+	}
+
+	for _, p := range phases {
+		is, err := getSolarIntervalsForEphemeris(sun.Noon, p.phase, p.lower, p.upper, longitude, latitude, ephemeris)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		intervals = append(intervals, is...)
+	}
+
+	for i := range intervals {
+		// the evening counterpart of the sunrise is the sunset:
+		if intervals[i].Phase == SolarPhaseSunrise && !intervals[i].Morning {
+			intervals[i].Phase = SolarPhaseSunset
+		}
+
+		intervals[i].From = intervals[i].From.In(location)
+
+		intervals[i].Until = intervals[i].Until.In(location)
+	}
+
+	sort.SliceStable(intervals, func(i, j int) bool {
+		return intervals[i].From.Before(intervals[j].From)
+	})
+
+	return intervals, location, nil
+}
+
+/*
+	getSolarAltitudeForEphemeris()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param ephemeris - the ephemeris backend, e.g., VSOP87Ephemeris{}
+	@returns the geometric altitude of the centre of the Sun, and its hour angle between [-180°, 180°), in degrees, as
+	for the events of getSolarEventForEphemeris()
+*/
+func getSolarAltitudeForEphemeris(datetime time.Time, longitude float64, latitude float64, ephemeris Ephemeris) (float64, float64, error) {
+	eq, err := GetEquatorialPosition(datetime, BodySun, ephemeris)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var H = getApparentHourAngle(datetime, longitude, eq.RightAscension)
+
+	return getHorizontalCoordinateFromHourAngle(H, eq.Declination, latitude).Altitude, H, nil
+}
+
+/*
+	the hour angle of the Sun (in degrees) within which it is classified as at its solar transit, i.e., ±1 minute of time
+*/
+var SOLAR_NOON_HOUR_ANGLE float64 = 0.25
+
+/*
+	GetSolarPhase()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param elevation - is the elevation (above sea level) in meters of some observer on Earth
+	@returns the phase of the solar day at the datetime
+*/
+func GetSolarPhase(datetime time.Time, longitude float64, latitude float64, elevation float64) SolarPhase {
+	phase, _ := GetSolarPhaseForEphemeris(datetime, longitude, latitude, elevation, VSOP87Ephemeris{})
+
+	return phase
+}
+
+/*
+	GetSolarPhaseForEphemeris()
+
+	N.B. the phases are classified by the altitudes of GetSolarDayTimelineForEphemeris(), and where its intervals overlap
+	the narrower phase is returned, i.e., the sunrise (or sunset) from the standard altitude until the lower limb of the
+	Sun meets the horizon, the golden hour from there to +6°, the civil twilight from -4° to the standard altitude, and
+	the blue hour from -6° to -4°; the solar noon is returned within SOLAR_NOON_HOUR_ANGLE of the transit.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param elevation - is the elevation (above sea level) in meters of some observer on Earth
+	@param ephemeris - the ephemeris backend, e.g., VSOP87Ephemeris{}
+	@returns the phase of the solar day at the datetime, or an error
+*/
+func GetSolarPhaseForEphemeris(datetime time.Time, longitude float64, latitude float64, elevation float64, ephemeris Ephemeris) (SolarPhase, error) {
+	h, H, err := getSolarAltitudeForEphemeris(datetime, longitude, latitude, ephemeris)
+
+	if err != nil {
+		return SolarPhaseNight, err
+	}
+
+	// the standard altitude of the Sun, and the altitude at which its lower limb meets the horizon, as for the timeline:
+	var h0 = getSolarStandardAltitude(0, elevation)
+
+	var h1 = h0 + GetSolarAngularDiameter(datetime)
+
+	switch {
+	case math.Abs(H) < SOLAR_NOON_HOUR_ANGLE:
+		return SolarPhaseNoon, nil
+	case h >= GOLDEN_HOUR_ALTITUDE:
+		return SolarPhaseDay, nil
+	case h >= h1:
+		return SolarPhaseGoldenHour, nil
+	case h >= h0 && H < 0:
+		return SolarPhaseSunrise, nil
+	case h >= h0:
+		return SolarPhaseSunset, nil
+	case h >= BLUE_HOUR_ALTITUDE:
+		return SolarPhaseCivilTwilight, nil
+	case h >= -6:
+		return SolarPhaseBlueHour, nil
+	case h >= -12:
+		return SolarPhaseNauticalTwilight, nil
+	case h >= -18:
+		return SolarPhaseAstronomicalTwilight, nil
+	}
+
+	return SolarPhaseNight, nil
+}
@@ -0,0 +1,197 @@
+package dusk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSolarPhaseString(t *testing.T) {
+	if SolarPhaseGoldenHour.String() != "Golden Hour" {
+		t.Errorf("got %s, wanted %s", SolarPhaseGoldenHour.String(), "Golden Hour")
+	}
+
+	if SolarPhase(99).String() != "Unknown" {
+		t.Errorf("got %s, wanted %s", SolarPhase(99).String(), "Unknown")
+	}
+}
+
+func TestGetSolarDayTimeline(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC)
+
+	got, location, err := GetSolarDayTimeline(datetime, longitude, latitude, elevation)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if location.String() != "Pacific/Honolulu" {
+		t.Errorf("got %q, wanted %q", location.String(), "Pacific/Honolulu")
+	}
+
+	var want = []struct {
+		phase   SolarPhase
+		morning bool
+	}{
+		{SolarPhaseAstronomicalTwilight, true},
+		{SolarPhaseNauticalTwilight, true},
+		{SolarPhaseCivilTwilight, true},
+		{SolarPhaseBlueHour, true},
+		{SolarPhaseGoldenHour, true},
+		{SolarPhaseSunrise, true},
+		{SolarPhaseNoon, false},
+		{SolarPhaseGoldenHour, false},
+		{SolarPhaseSunset, false},
+		{SolarPhaseCivilTwilight, false},
+		{SolarPhaseBlueHour, false},
+		{SolarPhaseNauticalTwilight, false},
+		{SolarPhaseAstronomicalTwilight, false},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d intervals, wanted %d", len(got), len(want))
+	}
+
+	for i, w := range want {
+		if got[i].Phase != w.phase || got[i].Morning != w.morning {
+			t.Errorf("got %s (morning: %t), wanted %s (morning: %t)", got[i].Phase, got[i].Morning, w.phase, w.morning)
+		}
+
+		if got[i].Until.Before(got[i].From) || got[i].Duration != got[i].Until.Sub(got[i].From) {
+			t.Errorf("got %q until %q, wanted a positive duration", got[i].From, got[i].Until)
+		}
+	}
+
+	// the boundaries of the phases are contiguous, e.g., the nautical twilight begins as the astronomical twilight ends:
+	if !got[0].Until.Equal(got[1].From) || !got[1].Until.Equal(got[2].From) || !got[3].Until.Equal(got[4].From) {
+		t.Errorf("got %v, wanted contiguous morning twilights", got[:5])
+	}
+
+	// the centre of the Sun is 18° below the horizon at ~20:10 HST:
+	var dusk = time.Date(2021, 5, 14, 20, 10, 30, 0, location)
+
+	if math.Abs(got[12].Until.Sub(dusk).Minutes()) > 2 {
+		t.Errorf("got %q, wanted %q", got[12].Until, dusk)
+	}
+
+	// the sunrise lasts a little over 2 minutes in the tropics:
+	if got[5].Duration < 2*time.Minute || got[5].Duration > 3*time.Minute {
+		t.Errorf("got %v, wanted between 2m and 3m", got[5].Duration)
+	}
+}
+
+func TestGetSolarDayTimelineWhiteNight(t *testing.T) {
+	// the Sun does not fall below -12° at Oslo, Norway about the summer solstice:
+	got, _, err := GetSolarDayTimeline(time.Date(2021, 6, 21, 0, 0, 0, 0, time.UTC), 10.7522, 59.9139, 0)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	var nautical = 0
+
+	for _, interval := range got {
+		if interval.Phase == SolarPhaseAstronomicalTwilight {
+			t.Errorf("got %v, wanted no astronomical twilight", interval)
+		}
+
+		if interval.Phase == SolarPhaseNauticalTwilight {
+			nautical++
+
+			// the nautical twilight lasts across midnight, from the evening until the following morning:
+			if interval.Morning || interval.Until.Sub(interval.From) < time.Hour {
+				t.Errorf("got %v, wanted a nautical twilight across midnight", interval)
+			}
+		}
+	}
+
+	if nautical != 1 {
+		t.Errorf("got %d, wanted %d", nautical, 1)
+	}
+}
+
+func TestGetSolarPhase(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC)
+
+	intervals, _, err := GetSolarDayTimeline(datetime, longitude, latitude, elevation)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	for _, interval := range intervals {
+		// the middle of each interval is classified as that phase, as the narrower of any overlapping phases is taken:
+		var got = GetSolarPhase(interval.From.Add(interval.Duration/2), longitude, latitude, elevation)
+
+		if got != interval.Phase {
+			t.Errorf("got %s, wanted %s", got, interval.Phase)
+		}
+	}
+
+	// the phases within the overlapping intervals of the timeline are classified by the narrower phase:
+	var want = []struct {
+		offset time.Duration
+		phase  SolarPhase
+	}{
+		// the sunrise lasts until the lower limb of the Sun meets the horizon, i.e., ~06:27 HST:
+		{-time.Second * 30, SolarPhaseSunrise},
+		// the end of the morning civil twilight, below the standard altitude:
+		{-time.Minute * 5, SolarPhaseCivilTwilight},
+		// the golden hour, once the Sun has risen:
+		{time.Minute * 10, SolarPhaseGoldenHour},
+	}
+
+	for _, interval := range intervals {
+		if interval.Phase != SolarPhaseSunrise {
+			continue
+		}
+
+		for _, w := range want {
+			if got := GetSolarPhase(interval.Until.Add(w.offset), longitude, latitude, elevation); got != w.phase {
+				t.Errorf("got %s, wanted %s", got, w.phase)
+			}
+		}
+	}
+
+	// the afternoon is during the day, and local midnight is during the night:
+	if got := GetSolarPhase(time.Date(2021, 5, 14, 22, 30, 0, 0, time.UTC), longitude, latitude, elevation); got != SolarPhaseDay {
+		t.Errorf("got %s, wanted %s", got, SolarPhaseDay)
+	}
+
+	if got := GetSolarPhase(time.Date(2021, 5, 14, 10, 18, 0, 0, time.UTC), longitude, latitude, elevation); got != SolarPhaseNight {
+		t.Errorf("got %s, wanted %s", got, SolarPhaseNight)
+	}
+}
+
+func TestGetSolarDayTimelinePolarCivilTwilight(t *testing.T) {
+	// the Sun remains between -6° and the horizon all day close to the north pole in early October:
+	got, _, err := GetSolarDayTimeline(time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC), 0, 89, 0)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	var civil = 0
+
+	for _, interval := range got {
+		if interval.Phase == SolarPhaseNauticalTwilight || interval.Phase == SolarPhaseSunrise || interval.Phase == SolarPhaseSunset {
+			t.Errorf("got %v, wanted no %s", interval, interval.Phase)
+		}
+
+		if interval.Phase == SolarPhaseCivilTwilight {
+			civil++
+
+			if interval.Duration != 24*time.Hour {
+				t.Errorf("got %v, wanted %v", interval.Duration, 24*time.Hour)
+			}
+
+			if got := GetSolarPhase(interval.From.Add(interval.Duration/4), 0, 89, 0); got != SolarPhaseCivilTwilight && got != SolarPhaseBlueHour {
+				t.Errorf("got %s, wanted %s", got, SolarPhaseCivilTwilight)
+			}
+		}
+	}
+
+	if civil != 1 {
+		t.Errorf("got %d, wanted %d", civil, 1)
+	}
+}
@@ -0,0 +1,124 @@
+package dusk
+
+import (
+	"math"
+	"time"
+)
+
+/*
+	GetEquationOfTime()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the equation of time (in minutes), i.e., the local apparent (sundial) time minus the local mean time, from the
+	apparent position of the Sun
+	@see ch.28 p.183 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetEquationOfTime(datetime time.Time) float64 {
+	var T = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
+
+	// the number of Julian millennia since J2000:
+	var τ = T / 10
+
+	// the mean longitude of the Sun, referred to the mean equinox of date (eq.28.2):
+	var L0 = 280.4664567 + 360007.6982779*τ + 0.03032028*math.Pow(τ, 2) + math.Pow(τ, 3)/49931 - math.Pow(τ, 4)/15300 - math.Pow(τ, 5)/2000000
+
+	var α = GetApparentSolarEquatorialPosition(datetime).RightAscension
+
+	var L, l, Ω = GetSolarMeanLongitude(T), GetLunarMeanLongitude(T), GetLunarLongitudeOfTheAscendingNode(T)
+
+	var ε = GetMeanObliquityOfTheEcliptic(T) + GetNutationInObliquityOfTheEcliptic(L, l, Ω)
+
+	// eq.28.3, where the nutation in right ascension Δψ cos ε refers α to the mean equinox:
+	var E = math.Mod(L0-0.0057183-α+GetNutationInLongitudeOfTheEcliptic(L, l, Ω)*cosx(ε), 360)
+
+	// ensure the equation of time is within ±180°:
+	if E >= 180 {
+		E -= 360
+	}
+
+	if E < -180 {
+		E += 360
+	}
+
+	// 1° of hour angle is 4 minutes of time:
+	return E * 4
+}
+
+/*
+	ConvertUTCToLocalMeanTime()
+
+	N.B. the returned time.Time carries the reading of a local mean time clock, and its location is nominal.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@returns the local mean time (LMT), i.e., Universal Time (UT1) offset by 4 minutes per degree of longitude
+*/
+func ConvertUTCToLocalMeanTime(datetime time.Time, longitude float64) time.Time {
+	return addSeconds(ConvertUTCToUT1(datetime), longitude*240)
+}
+
+/*
+	ConvertLocalMeanTimeToUTC()
+
+	@param datetime - the reading of a local mean time (LMT) clock
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@returns the corresponding Coordinated Universal Time (UTC), which may be converted to civil time with In()
+*/
+func ConvertLocalMeanTimeToUTC(datetime time.Time, longitude float64) time.Time {
+	return ConvertUT1ToUTC(addSeconds(datetime, -longitude*240))
+}
+
+/*
+	ConvertUTCToLocalApparentSolarTime()
+
+	N.B. the returned time.Time carries the reading of a sundial, and its location is nominal.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@returns the local apparent solar time (LAT), i.e., the hour angle of the Sun + 12 hours
+*/
+func ConvertUTCToLocalApparentSolarTime(datetime time.Time, longitude float64) time.Time {
+	return addSeconds(ConvertUTCToLocalMeanTime(datetime, longitude), GetEquationOfTime(datetime)*60)
+}
+
+/*
+	ConvertLocalApparentSolarTimeToUTC()
+
+	@param datetime - the reading of a sundial, i.e., the local apparent solar time (LAT)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@returns the corresponding Coordinated Universal Time (UTC), which may be converted to civil time with In()
+*/
+func ConvertLocalApparentSolarTimeToUTC(datetime time.Time, longitude float64) time.Time {
+	var lmt = datetime
+
+	// iterate, as the equation of time is a function of UTC rather than of the apparent solar time:
+	for i := 0; i < 3; i++ {
+		lmt = addSeconds(datetime, -GetEquationOfTime(ConvertLocalMeanTimeToUTC(lmt, longitude))*60)
+	}
+
+	return ConvertLocalMeanTimeToUTC(lmt, longitude)
+}
+
+/*
+	GetSolarNoonInUTC()
+
+	@param datetime - the datetime of the observer, whose calendar date is used
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@returns the datetime (in UTC) of local apparent noon, i.e., the transit of the Sun across the observer's meridian
+*/
+func GetSolarNoonInUTC(datetime time.Time, longitude float64) time.Time {
+	var noon = time.Date(datetime.Year(), datetime.Month(), datetime.Day(), 12, 0, 0, 0, time.UTC)
+
+	return ConvertLocalApparentSolarTimeToUTC(noon, longitude)
+}
+
+/*
+	GetSolarNoon()
+
+	@param datetime - the datetime of the observer, whose calendar date is used
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@returns the datetime of local apparent noon, in the location of the given datetime
+*/
+func GetSolarNoon(datetime time.Time, longitude float64) time.Time {
+	return GetSolarNoonInUTC(datetime, longitude).In(datetime.Location())
+}
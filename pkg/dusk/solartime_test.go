@@ -0,0 +1,110 @@
+package dusk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGetEquationOfTime(t *testing.T) {
+	// see ex.28.a p.185 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	var got = GetEquationOfTime(ConvertTTToUTC(time.Date(1992, 10, 13, 0, 0, 0, 0, time.UTC)))
+
+	// +13m42.7s:
+	var want = 13 + 42.7/60
+
+	if math.Abs(got-want) > 1.0/60 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetEquationOfTimeExtrema(t *testing.T) {
+	// the Sun is ~16m25s fast in early November, and ~14m15s slow in mid February:
+	var november = GetEquationOfTime(time.Date(2021, 11, 3, 12, 0, 0, 0, time.UTC))
+
+	if math.Abs(november-16.42) > 0.05 {
+		t.Errorf("got %f, wanted %f", november, 16.42)
+	}
+
+	var february = GetEquationOfTime(time.Date(2021, 2, 11, 12, 0, 0, 0, time.UTC))
+
+	if math.Abs(february+14.23) > 0.05 {
+		t.Errorf("got %f, wanted %f", february, -14.23)
+	}
+}
+
+func TestConvertUTCToLocalMeanTime(t *testing.T) {
+	var datetime = time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC)
+
+	var got = ConvertUTCToLocalMeanTime(datetime, longitude)
+
+	var want = datetime.Add(time.Duration(longitude * 240 * float64(time.Second)))
+
+	// LMT differs from UTC only by the longitude, and by DUT1 (|UT1 - UTC| < 0.9s):
+	if math.Abs(got.Sub(want).Seconds()) > 0.9 {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+
+	var utc = ConvertLocalMeanTimeToUTC(got, longitude)
+
+	if math.Abs(utc.Sub(datetime).Seconds()) > 0.001 {
+		t.Errorf("got %q, wanted %q", utc, datetime)
+	}
+}
+
+func TestConvertUTCToLocalApparentSolarTime(t *testing.T) {
+	var datetime = time.Date(2021, 11, 3, 0, 0, 0, 0, time.UTC)
+
+	var got = ConvertUTCToLocalApparentSolarTime(datetime, longitude)
+
+	var want = ConvertUTCToLocalMeanTime(datetime, longitude)
+
+	if math.Abs(got.Sub(want).Minutes()-GetEquationOfTime(datetime)) > 0.0001 {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+
+	var utc = ConvertLocalApparentSolarTimeToUTC(got, longitude)
+
+	if math.Abs(utc.Sub(datetime).Seconds()) > 0.001 {
+		t.Errorf("got %q, wanted %q", utc, datetime)
+	}
+}
+
+func TestGetSolarNoonInUTC(t *testing.T) {
+	// at Greenwich, the Sun transits ~16m25s before 12:00 UTC in early November:
+	var got = GetSolarNoonInUTC(time.Date(2021, 11, 3, 0, 0, 0, 0, time.UTC), 0)
+
+	var want = time.Date(2021, 11, 3, 11, 43, 35, 0, time.UTC)
+
+	if math.Abs(got.Sub(want).Seconds()) > 5 {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+
+	// the hour angle of the Sun is zero at local apparent noon:
+	var eq = GetApparentSolarEquatorialPosition(got)
+
+	var H = math.Mod(GetSiderealTime(got, MeeusApparentSiderealTime)*15-eq.RightAscension+540, 360) - 180
+
+	if math.Abs(H) > 0.01 {
+		t.Errorf("got %f, wanted %f", H, 0.0)
+	}
+}
+
+func TestGetSolarNoon(t *testing.T) {
+	location, err := time.LoadLocation("Pacific/Honolulu")
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	var got = GetSolarNoon(time.Date(2021, 5, 14, 0, 0, 0, 0, location), longitude)
+
+	if got.Location().String() != "Pacific/Honolulu" {
+		t.Errorf("got %q, wanted %q", got.Location().String(), "Pacific/Honolulu")
+	}
+
+	// the Sun transits at ~12:18 HST:
+	if got.Day() != 14 || got.Hour() != 12 || got.Minute() < 17 || got.Minute() > 19 {
+		t.Errorf("got %q, wanted approximately 12:18 HST", got)
+	}
+}
@@ -0,0 +1,311 @@
+package dusk
+
+import (
+	"math"
+	"time"
+)
+
+/*
+	a periodic term of the IAU 1980 theory of nutation, i.e., the multiples of the arguments D, M, M', F and Ω, and the
+	coefficients (a + bT) sin and (c + dT) cos of the nutation in longitude and obliquity (in 0.0001")
+*/
+type nutationTerm struct {
+	D, M, Mʹ, F, Ω int
+	a, b, c, d     float64
+}
+
+/*
+	the periodic terms of the IAU 1980 theory of nutation
+
+	@see Table 22.A p.145 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+var nutationTerms = []nutationTerm{
+	{0, 0, 0, 0, 1, -171996, -174.2, 92025, 8.9},
+	{-2, 0, 0, 2, 2, -13187, -1.6, 5736, -3.1},
+	{0, 0, 0, 2, 2, -2274, -0.2, 977, -0.5},
+	{0, 0, 0, 0, 2, 2062, 0.2, -895, 0.5},
+	{0, 1, 0, 0, 0, 1426, -3.4, 54, -0.1},
+	{0, 0, 1, 0, 0, 712, 0.1, -7, 0},
+	{-2, 1, 0, 2, 2, -517, 1.2, 224, -0.6},
+	{0, 0, 0, 2, 1, -386, -0.4, 200, 0},
+	{0, 0, 1, 2, 2, -301, 0, 129, -0.1},
+	{-2, -1, 0, 2, 2, 217, -0.5, -95, 0.3},
+	{-2, 0, 1, 0, 0, -158, 0, 0, 0},
+	{-2, 0, 0, 2, 1, 129, 0.1, -70, 0},
+	{0, 0, -1, 2, 2, 123, 0, -53, 0},
+	{2, 0, 0, 0, 0, 63, 0, 0, 0},
+	{0, 0, 1, 0, 1, 63, 0.1, -33, 0},
+	{2, 0, -1, 2, 2, -59, 0, 26, 0},
+	{0, 0, -1, 0, 1, -58, -0.1, 32, 0},
+	{0, 0, 1, 2, 1, -51, 0, 27, 0},
+	{-2, 0, 2, 0, 0, 48, 0, 0, 0},
+	{0, 0, -2, 2, 1, 46, 0, -24, 0},
+	{2, 0, 0, 2, 2, -38, 0, 16, 0},
+	{0, 0, 2, 2, 2, -31, 0, 13, 0},
+	{0, 0, 2, 0, 0, 29, 0, 0, 0},
+	{-2, 0, 1, 2, 2, 29, 0, -12, 0},
+	{0, 0, 0, 2, 0, 26, 0, 0, 0},
+	{-2, 0, 0, 2, 0, -22, 0, 0, 0},
+	{0, 0, -1, 2, 1, 21, 0, -10, 0},
+	{0, 2, 0, 0, 0, 17, -0.1, 0, 0},
+	{2, 0, -1, 0, 1, 16, 0, -8, 0},
+	{-2, 2, 0, 2, 2, -16, 0.1, 7, 0},
+	{0, 1, 0, 0, 1, -15, 0, 9, 0},
+	{-2, 0, 1, 0, 1, -13, 0, 7, 0},
+	{0, -1, 0, 0, 1, -12, 0, 6, 0},
+	{0, 0, 2, -2, 0, 11, 0, 0, 0},
+	{2, 0, -1, 2, 1, -10, 0, 5, 0},
+	{2, 0, 1, 2, 2, -8, 0, 3, 0},
+	{0, 1, 0, 2, 2, 7, 0, -3, 0},
+	{-2, 1, 1, 0, 0, -7, 0, 0, 0},
+	{0, -1, 0, 2, 2, -7, 0, 3, 0},
+	{2, 0, 0, 2, 1, -7, 0, 3, 0},
+	{2, 0, 1, 0, 0, 6, 0, 0, 0},
+	{-2, 0, 2, 2, 2, 6, 0, -3, 0},
+	{-2, 0, 1, 2, 1, 6, 0, -3, 0},
+	{2, 0, -2, 0, 1, -6, 0, 3, 0},
+	{2, 0, 0, 0, 1, -6, 0, 3, 0},
+	{0, -1, 1, 0, 0, 5, 0, 0, 0},
+	{-2, -1, 0, 2, 1, -5, 0, 3, 0},
+	{-2, 0, 0, 0, 1, -5, 0, 3, 0},
+	{0, 0, 2, 2, 1, -5, 0, 3, 0},
+	{-2, 0, 2, 0, 1, 4, 0, 0, 0},
+	{-2, 1, 0, 2, 1, 4, 0, 0, 0},
+	{0, 0, 1, -2, 0, 4, 0, 0, 0},
+	{-1, 0, 1, 0, 0, -4, 0, 0, 0},
+	{-2, 1, 0, 0, 0, -4, 0, 0, 0},
+	{1, 0, 0, 0, 0, -4, 0, 0, 0},
+	{0, 0, 1, 2, 0, 3, 0, 0, 0},
+	{0, 0, -2, 2, 2, -3, 0, 0, 0},
+	{-1, -1, 1, 0, 0, -3, 0, 0, 0},
+	{0, 1, 1, 0, 0, -3, 0, 0, 0},
+	{0, -1, 1, 2, 2, -3, 0, 0, 0},
+	{2, -1, -1, 2, 2, -3, 0, 0, 0},
+	{0, 0, 3, 2, 2, -3, 0, 0, 0},
+	{2, -1, 0, 2, 2, -3, 0, 0, 0},
+}
+
+type NRELSolarPositionParameters struct {
+	/*
+		Longitude - the longitude (west is negative, east is positive) of the observer, in degrees
+	*/
+	Longitude float64 `json:"longitude"`
+	/*
+		Latitude - the latitude (south is negative, north is positive) of the observer, in degrees
+	*/
+	Latitude float64 `json:"latitude"`
+	/*
+		Elevation - the elevation (above sea level) of the observer, in metres
+	*/
+	Elevation float64 `json:"elevation"`
+	/*
+		Pressure - the annual average local pressure, in millibars
+	*/
+	Pressure float64 `json:"pressure"`
+	/*
+		Temperature - the annual average local temperature, in °C
+	*/
+	Temperature float64 `json:"temperature"`
+	/*
+		ΔT - the difference between Terrestrial Time (TT) and Universal Time (UT1), in seconds
+	*/
+	ΔT float64 `json:"deltaT"`
+	/*
+		Slope - the tilt of the surface from the horizontal, in degrees
+	*/
+	Slope float64 `json:"slope"`
+	/*
+		AzimuthRotation - the azimuth of the surface normal, measured westwards from south, in degrees
+	*/
+	AzimuthRotation float64 `json:"azimuthRotation"`
+	/*
+		AtmosphericRefraction - the atmospheric refraction at sunrise and sunset, in degrees (typically 0.5667°)
+	*/
+	AtmosphericRefraction float64 `json:"atmosphericRefraction"`
+}
+
+type NRELSolarPosition struct {
+	/*
+		Zenith - the topocentric zenith angle, corrected for atmospheric refraction, in degrees
+	*/
+	Zenith float64 `json:"zenith"`
+	/*
+		Azimuth - the topocentric azimuth, eastwards from north, in degrees
+	*/
+	Azimuth float64 `json:"azimuth"`
+	/*
+		Incidence - the angle of incidence on the surface, in degrees
+	*/
+	Incidence float64 `json:"incidence"`
+	/*
+		RightAscension - the topocentric right ascension, in degrees
+	*/
+	RightAscension float64 `json:"ra"`
+	/*
+		Declination - the topocentric declination, in degrees
+	*/
+	Declination float64 `json:"dec"`
+	/*
+		HourAngle - the topocentric local hour angle, in degrees
+	*/
+	HourAngle float64 `json:"hourAngle"`
+}
+
+/*
+	getNormalisedDegrees()
+
+	@param θ - an angle (in degrees)
+	@returns the angle within [0°, 360°)
+*/
+func getNormalisedDegrees(θ float64) float64 {
+	θ = math.Mod(θ, 360)
+
+	// correct for negative angles
+	if θ < 0 {
+		θ += 360
+	}
+
+	return θ
+}
+
+/*
+	getNutationIAU1980()
+
+	@param T - the number of Julian centuries since J2000 (in TT)
+	@returns the nutation in longitude Δψ and in obliquity Δε (in degrees), from the 63 periodic terms of the IAU 1980 theory
+	@see ch.22 p.143 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func getNutationIAU1980(T float64) (float64, float64) {
+	// the mean elongation of the Moon from the Sun:
+	var D = 297.85036 + 445267.111480*T - 0.0019142*math.Pow(T, 2) + math.Pow(T, 3)/189474
+
+	// the mean anomaly of the Sun:
+	var M = 357.52772 + 35999.050340*T - 0.0001603*math.Pow(T, 2) - math.Pow(T, 3)/300000
+
+	// the mean anomaly of the Moon:
+	var Mʹ = 134.96298 + 477198.867398*T + 0.0086972*math.Pow(T, 2) + math.Pow(T, 3)/56250
+
+	// the argument of latitude of the Moon:
+	var F = 93.27191 + 483202.017538*T - 0.0036825*math.Pow(T, 2) + math.Pow(T, 3)/327270
+
+	// the longitude of the ascending node of the Moon's mean orbit on the ecliptic:
+	var Ω = 125.04452 - 1934.136261*T + 0.0020708*math.Pow(T, 2) + math.Pow(T, 3)/450000
+
+	var Δψ, Δε float64 = 0, 0
+
+	for _, term := range nutationTerms {
+		var argument = float64(term.D)*D + float64(term.M)*M + float64(term.Mʹ)*Mʹ + float64(term.F)*F + float64(term.Ω)*Ω
+
+		Δψ += (term.a + term.b*T) * sinx(argument)
+
+		Δε += (term.c + term.d*T) * cosx(argument)
+	}
+
+	// the coefficients are in units of 0.0001":
+	return Δψ / 36000000, Δε / 36000000
+}
+
+/*
+	GetNRELSolarPosition()
+
+	N.B. the datetime is taken to be Universal Time (UT1), i.e., with UT1 - UTC neglected.
+
+	@param datetime - the datetime of the observer (in UT)
+	@param parameters - the observer, the local atmosphere, ΔT and the orientation of the surface
+	@returns the topocentric position of the Sun, to within ±0.0003° between the years -2000 and 6000
+	@see Reda, I. & Andreas, A. 2008. Solar Position Algorithm for Solar Radiation Applications. NREL/TP-560-34302 (revised)
+*/
+func GetNRELSolarPosition(datetime time.Time, parameters NRELSolarPositionParameters) NRELSolarPosition {
+	// the number of Julian days since J2000, in UT and in TT:
+	var d = GetPreciseJulianDate(datetime).SubFloat(J2000)
+
+	var JC = d / 36525
+
+	var JCE = (d + parameters.ΔT/86400) / 36525
+
+	// the number of Julian millennia since J2000 (in TT):
+	var JME = JCE / 10
+
+	// the heliocentric longitude, latitude and radius vector of the Earth (§3.2):
+	var L = getNormalisedDegrees(getVSOP87Series(vsop87EarthL, JME) * 180 / math.Pi)
+
+	var B = getVSOP87Series(vsop87EarthB, JME) * 180 / math.Pi
+
+	var R = getVSOP87Series(vsop87EarthR, JME)
+
+	// the geocentric longitude and latitude of the Sun (§3.3):
+	var Θ = getNormalisedDegrees(L + 180)
+
+	var β = -B
+
+	// the nutation in longitude and obliquity (§3.4):
+	Δψ, Δε := getNutationIAU1980(JCE)
+
+	// the true obliquity of the ecliptic (§3.5):
+	var U = JME / 10
+
+	var ε0 = 84381.448 - 4680.93*U - 1.55*math.Pow(U, 2) + 1999.25*math.Pow(U, 3) - 51.38*math.Pow(U, 4) - 249.67*math.Pow(U, 5) -
+		39.05*math.Pow(U, 6) + 7.12*math.Pow(U, 7) + 27.87*math.Pow(U, 8) + 5.79*math.Pow(U, 9) + 2.45*math.Pow(U, 10)
+
+	var ε = ε0/3600 + Δε
+
+	// the apparent longitude of the Sun, corrected for nutation and aberration (§3.6 & §3.7):
+	var λ = Θ + Δψ - 20.4898/(3600*R)
+
+	// the apparent sidereal time at Greenwich (§3.8):
+	var ν = getNormalisedDegrees(280.46061837+360.98564736629*d+0.000387933*math.Pow(JC, 2)-math.Pow(JC, 3)/38710000) + Δψ*cosx(ε)
+
+	// the geocentric right ascension and declination of the Sun (§3.9 & §3.10):
+	var α = getNormalisedDegrees(atan2yx(sinx(λ)*cosx(ε)-tanx(β)*sinx(ε), cosx(λ)))
+
+	var δ = asinx(sinx(β)*cosx(ε) + cosx(β)*sinx(ε)*sinx(λ))
+
+	// the observer local hour angle (§3.11):
+	var H = getNormalisedDegrees(ν + parameters.Longitude - α)
+
+	// the equatorial horizontal parallax of the Sun (§3.12.1):
+	var ξ = 8.794 / (3600 * R)
+
+	var φ = parameters.Latitude
+
+	var u = atanx(0.99664719 * tanx(φ))
+
+	var x = cosx(u) + parameters.Elevation/6378140*cosx(φ)
+
+	var y = 0.99664719*sinx(u) + parameters.Elevation/6378140*sinx(φ)
+
+	// the parallax in the right ascension of the Sun (§3.12.4):
+	var Δα = atan2yx(-x*sinx(ξ)*sinx(H), cosx(δ)-x*sinx(ξ)*cosx(H))
+
+	// the topocentric declination and local hour angle of the Sun (§3.12.6 & §3.13):
+	var δʹ = atan2yx((sinx(δ)-y*sinx(ξ))*cosx(Δα), cosx(δ)-x*sinx(ξ)*cosx(H))
+
+	var Hʹ = H - Δα
+
+	// the topocentric elevation angle, without and with the correction for atmospheric refraction (§3.14):
+	var e0 = asinx(sinx(φ)*sinx(δʹ) + cosx(φ)*cosx(δʹ)*cosx(Hʹ))
+
+	var Δe float64 = 0
+
+	// the Sun is refracted only when above the horizon, i.e., its upper limb at the altitude of the refracted horizon:
+	if e0 >= -(0.26667 + parameters.AtmosphericRefraction) {
+		Δe = (parameters.Pressure / 1010) * (283 / (273 + parameters.Temperature)) * 1.02 / (60 * tanx(e0+10.3/(e0+5.11)))
+	}
+
+	var θ = 90 - (e0 + Δe)
+
+	// the topocentric astronomers azimuth, i.e., measured westwards from south (§3.15):
+	var Γ = getNormalisedDegrees(atan2yx(sinx(Hʹ), cosx(Hʹ)*sinx(φ)-tanx(δʹ)*cosx(φ)))
+
+	// the incidence angle for a surface oriented in any direction (§3.16):
+	var I = acosx(cosx(θ)*cosx(parameters.Slope) + sinx(parameters.Slope)*sinx(θ)*cosx(Γ-parameters.AzimuthRotation))
+
+	return NRELSolarPosition{
+		Zenith:         θ,
+		Azimuth:        getNormalisedDegrees(Γ + 180),
+		Incidence:      I,
+		RightAscension: getNormalisedDegrees(α + Δα),
+		Declination:    δʹ,
+		HourAngle:      Hʹ,
+	}
+}
@@ -0,0 +1,79 @@
+package dusk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// the reference test case of Table A5.1 of Reda, I. & Andreas, A. 2008. Solar Position Algorithm for Solar Radiation
+// Applications. NREL/TP-560-34302 (revised), i.e., 17 October 2003 12:30:30 MST at Golden, Colorado:
+var spaDatetime = time.Date(2003, 10, 17, 19, 30, 30, 0, time.UTC)
+
+var spaParameters = NRELSolarPositionParameters{
+	Longitude:             -105.1786,
+	Latitude:              39.742476,
+	Elevation:             1830.14,
+	Pressure:              820,
+	Temperature:           11,
+	ΔT:                    67,
+	Slope:                 30,
+	AzimuthRotation:       -10,
+	AtmosphericRefraction: 0.5667,
+}
+
+func TestGetNutationIAU1980(t *testing.T) {
+	// see ex.22.a p.148 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+	Δψ, Δε := getNutationIAU1980((2446895.5 - 2451545.0) / 36525)
+
+	if math.Abs(Δψ*3600+3.788) > 0.001 {
+		t.Errorf("got %f, wanted %f", Δψ*3600, -3.788)
+	}
+
+	if math.Abs(Δε*3600-9.443) > 0.001 {
+		t.Errorf("got %f, wanted %f", Δε*3600, 9.443)
+	}
+}
+
+func TestGetNRELSolarPosition(t *testing.T) {
+	var got = GetNRELSolarPosition(spaDatetime, spaParameters)
+
+	if math.Abs(got.Zenith-50.11162) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got.Zenith, 50.11162)
+	}
+
+	if math.Abs(got.Azimuth-194.34024) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got.Azimuth, 194.34024)
+	}
+
+	if math.Abs(got.Incidence-25.18700) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got.Incidence, 25.18700)
+	}
+
+	if math.Abs(got.RightAscension-202.22704) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got.RightAscension, 202.22704)
+	}
+
+	if math.Abs(got.Declination+9.316179) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got.Declination, -9.316179)
+	}
+}
+
+func TestGetNRELSolarPositionAgreesWithVSOP87(t *testing.T) {
+	var got = GetNRELSolarPosition(spaDatetime, NRELSolarPositionParameters{
+		Longitude: spaParameters.Longitude,
+		Latitude:  spaParameters.Latitude,
+		ΔT:        GetDeltaT(spaDatetime),
+	})
+
+	var want = GetSolarHorizontalPosition(spaDatetime, spaParameters.Longitude, spaParameters.Latitude)
+
+	// without refraction, the zenith agrees with the geometric altitude to within the parallax and sidereal time models:
+	if math.Abs(90-got.Zenith-want.Altitude) > 0.01 {
+		t.Errorf("got %f, wanted %f", 90-got.Zenith, want.Altitude)
+	}
+
+	if math.Abs(got.Azimuth-want.Azimuth) > 0.01 {
+		t.Errorf("got %f, wanted %f", got.Azimuth, want.Azimuth)
+	}
+}
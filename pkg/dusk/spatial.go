@@ -0,0 +1,344 @@
+package dusk
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+/*
+	SkyIndex is a static kd-tree of the unit vectors of a set of equatorial coordinates on the celestial sphere, where
+	each node holds the axis-aligned bounding box of its subtree so that whole branches can be rejected from a search.
+*/
+type SkyIndex struct {
+	coordinates []EquatorialCoordinate
+	vectors     [][3]float64
+	nodes       []skyIndexNode
+	root        int
+}
+
+type skyIndexNode struct {
+	index       int
+	axis        int
+	left, right int
+	min, max    [3]float64
+}
+
+type SkyIndexMatch struct {
+	/*
+		Index - the index of the matched coordinate, in the slice the SkyIndex was built from
+	*/
+	Index int `json:"index"`
+	/*
+		Separation - the angular separation of the matched coordinate from the search centre (in degrees)
+	*/
+	Separation float64 `json:"separation"`
+}
+
+/*
+	convertEquatorialCoordinateToUnitVector()
+
+	@param eq - the equatorial coordinate { ra, dec } (in degrees)
+	@returns the rectangular unit vector { x, y, z } pointing towards the coordinate on the celestial sphere
+*/
+func convertEquatorialCoordinateToUnitVector(eq EquatorialCoordinate) [3]float64 {
+	var sα, cα = sincosx(eq.RightAscension)
+
+	var sδ, cδ = sincosx(eq.Declination)
+
+	return [3]float64{cδ * cα, cδ * sα, sδ}
+}
+
+/*
+	NewSkyIndex()
+
+	@param coordinates - the equatorial coordinates { ra, dec } to index (in degrees)
+	@returns a spatial index over the coordinates, where search results refer to positions in the given slice
+*/
+func NewSkyIndex(coordinates []EquatorialCoordinate) *SkyIndex {
+	var s = &SkyIndex{
+		coordinates: make([]EquatorialCoordinate, len(coordinates)),
+		vectors:     make([][3]float64, len(coordinates)),
+		nodes:       make([]skyIndexNode, 0, len(coordinates)),
+		root:        -1,
+	}
+
+	copy(s.coordinates, coordinates)
+
+	var indices = make([]int, len(coordinates))
+
+	for i, eq := range coordinates {
+		s.vectors[i] = convertEquatorialCoordinateToUnitVector(eq)
+		indices[i] = i
+	}
+
+	s.root = s.build(indices)
+
+	return s
+}
+
+/*
+	build()
+
+	@param indices - the indices of the coordinates to place in this subtree
+	@returns the node index of the subtree root, or -1 if the subtree is empty
+*/
+func (s *SkyIndex) build(indices []int) int {
+	if len(indices) == 0 {
+		return -1
+	}
+
+	var node = skyIndexNode{
+		min: [3]float64{math.Inf(1), math.Inf(1), math.Inf(1)},
+		max: [3]float64{math.Inf(-1), math.Inf(-1), math.Inf(-1)},
+	}
+
+	for _, i := range indices {
+		for k := 0; k < 3; k++ {
+			node.min[k] = math.Min(node.min[k], s.vectors[i][k])
+			node.max[k] = math.Max(node.max[k], s.vectors[i][k])
+		}
+	}
+
+	// split along the axis of greatest extent:
+	for k := 1; k < 3; k++ {
+		if node.max[k]-node.min[k] > node.max[node.axis]-node.min[node.axis] {
+			node.axis = k
+		}
+	}
+
+	sort.Slice(indices, func(a, b int) bool {
+		return s.vectors[indices[a]][node.axis] < s.vectors[indices[b]][node.axis]
+	})
+
+	var median = len(indices) / 2
+
+	node.index = indices[median]
+
+	var n = len(s.nodes)
+
+	s.nodes = append(s.nodes, node)
+
+	var left = s.build(indices[:median])
+
+	var right = s.build(indices[median+1:])
+
+	s.nodes[n].left = left
+
+	s.nodes[n].right = right
+
+	return n
+}
+
+/*
+	Len()
+
+	@returns the number of coordinates in the index
+*/
+func (s *SkyIndex) Len() int {
+	return len(s.coordinates)
+}
+
+/*
+	ConeSearch()
+
+	@param centre - the equatorial coordinate { ra, dec } of the centre of the search cone (in degrees)
+	@param radius - the angular radius of the search cone (in degrees)
+	@returns the matched coordinates within the radius, sorted by ascending angular separation (as per GetAngularSeparation())
+*/
+func (s *SkyIndex) ConeSearch(centre EquatorialCoordinate, radius float64) []SkyIndexMatch {
+	var matches = []SkyIndexMatch{}
+
+	if radius < 0 || s.root < 0 {
+		return matches
+	}
+
+	var q = convertEquatorialCoordinateToUnitVector(centre)
+
+	// the straight-line (chord) distance between two unit vectors separated by the radius, with a small tolerance
+	// so that the exact angular separation test below is the deciding one:
+	var chord = 2*sinx(math.Min(radius, 180)/2) + 1e-9
+
+	var c = Coordinate{Latitude: centre.Declination, Longitude: centre.RightAscension}
+
+	s.walk(s.root, func(node *skyIndexNode) bool {
+		var d2 float64 = 0
+
+		for k := 0; k < 3; k++ {
+			var d = math.Max(0, math.Max(node.min[k]-q[k], q[k]-node.max[k]))
+			d2 += d * d
+		}
+
+		return d2 <= chord*chord
+	}, func(i int) {
+		var eq = s.coordinates[i]
+
+		var θ = GetAngularSeparation(c, Coordinate{Latitude: eq.Declination, Longitude: eq.RightAscension})
+
+		if θ <= radius {
+			matches = append(matches, SkyIndexMatch{Index: i, Separation: θ})
+		}
+	})
+
+	sort.SliceStable(matches, func(a, b int) bool {
+		return matches[a].Separation < matches[b].Separation
+	})
+
+	return matches
+}
+
+/*
+	BoxSearch()
+
+	N.B. if raFrom is greater than raTo the box is taken to wrap through 0h, e.g., raFrom = 350° and raTo = 10°, and if
+	raTo is at least 360° beyond raFrom the box spans the full circle of right ascension, e.g., raFrom = 0° and raTo = 360°.
+
+	@param raFrom - the lower bound of right ascension (in degrees)
+	@param raTo - the upper bound of right ascension (in degrees)
+	@param decFrom - the lower bound of declination (in degrees)
+	@param decTo - the upper bound of declination (in degrees)
+	@returns the indices of the coordinates within the box, in ascending order
+*/
+func (s *SkyIndex) BoxSearch(raFrom float64, raTo float64, decFrom float64, decTo float64) []int {
+	var matches = []int{}
+
+	if s.root < 0 || decFrom > decTo {
+		return matches
+	}
+
+	// the full circle must be detected before normalising, as raTo = 360° would otherwise normalise onto raFrom = 0°:
+	var full = raTo-raFrom >= 360
+
+	raFrom = normaliseRightAscension(raFrom)
+
+	raTo = normaliseRightAscension(raTo)
+
+	var width = raTo - raFrom
+
+	if width < 0 {
+		width += 360
+	}
+
+	if full {
+		width = 360
+	}
+
+	var zmin, zmax = sinx(decFrom), sinx(decTo)
+
+	// the right ascension wedge is the intersection of two half-spaces (through the polar axis) when narrower than 180°:
+	var n1 = [3]float64{-sinx(raFrom), cosx(raFrom), 0}
+
+	var n2 = [3]float64{sinx(raTo), -cosx(raTo), 0}
+
+	s.walk(s.root, func(node *skyIndexNode) bool {
+		if node.max[2] < zmin || node.min[2] > zmax {
+			return false
+		}
+
+		if width <= 180 {
+			return getMaximumDotProductOverBox(n1, node) >= -1e-12 && getMaximumDotProductOverBox(n2, node) >= -1e-12
+		}
+
+		return true
+	}, func(i int) {
+		var eq = s.coordinates[i]
+
+		if eq.Declination < decFrom || eq.Declination > decTo {
+			return
+		}
+
+		var Δα = normaliseRightAscension(eq.RightAscension) - raFrom
+
+		if Δα < 0 {
+			Δα += 360
+		}
+
+		if Δα <= width {
+			matches = append(matches, i)
+		}
+	})
+
+	sort.Ints(matches)
+
+	return matches
+}
+
+/*
+	SearchAboveAltitude()
+
+	N.B. the indexed coordinates are assumed to be referred to the mean equinox of J2000, as for the catalogues.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param altitude - the minimum altitude above the horizon (in degrees)
+	@returns the matched coordinates above the altitude, sorted by ascending angular separation from the zenith
+*/
+func (s *SkyIndex) SearchAboveAltitude(datetime time.Time, longitude float64, latitude float64, altitude float64) []SkyIndexMatch {
+	// the zenith lies at an hour angle of zero, i.e., at a right ascension equal to the local sidereal time:
+	var zenith = EquatorialCoordinate{
+		RightAscension: GetLocalSiderealTime(datetime, longitude) * 15,
+		Declination:    latitude,
+	}
+
+	return s.ConeSearch(ConvertEpochOfDateEquatorialCoordinateToJ2000(datetime, zenith), 90-altitude)
+}
+
+/*
+	walk()
+
+	@param n - the node index of the subtree root
+	@param visit - reports whether the subtree's bounding box may contain a match
+	@param match - called with the coordinate index of every node of every subtree that is visited
+*/
+func (s *SkyIndex) walk(n int, visit func(node *skyIndexNode) bool, match func(i int)) {
+	if n < 0 {
+		return
+	}
+
+	var node = &s.nodes[n]
+
+	if !visit(node) {
+		return
+	}
+
+	match(node.index)
+
+	s.walk(node.left, visit, match)
+
+	s.walk(node.right, visit, match)
+}
+
+/*
+	getMaximumDotProductOverBox()
+
+	@param n - the normal vector of a half-space through the origin
+	@param node - the node holding the axis-aligned bounding box
+	@returns the maximum value of n · p for any point p within the bounding box
+*/
+func getMaximumDotProductOverBox(n [3]float64, node *skyIndexNode) float64 {
+	var d float64 = 0
+
+	for k := 0; k < 3; k++ {
+		d += math.Max(n[k]*node.min[k], n[k]*node.max[k])
+	}
+
+	return d
+}
+
+/*
+	normaliseRightAscension()
+
+	@param ra - the right ascension (in degrees)
+	@returns the right ascension corrected to between [0°, 360°)
+*/
+func normaliseRightAscension(ra float64) float64 {
+	var α = math.Mod(ra, 360)
+
+	// correct for negative angles
+	if α < 0 {
+		α += 360
+	}
+
+	return α
+}
@@ -0,0 +1,177 @@
+package dusk
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func getRandomEquatorialCoordinates(n int) []EquatorialCoordinate {
+	var r = rand.New(rand.NewSource(42))
+
+	var coordinates = make([]EquatorialCoordinate, n)
+
+	for i := range coordinates {
+		coordinates[i] = EquatorialCoordinate{
+			RightAscension: r.Float64() * 360,
+			Declination:    asinx(2*r.Float64() - 1),
+		}
+	}
+
+	return coordinates
+}
+
+func TestNewSkyIndexEmpty(t *testing.T) {
+	var index = NewSkyIndex([]EquatorialCoordinate{})
+
+	if index.Len() != 0 {
+		t.Errorf("got %d, wanted %d", index.Len(), 0)
+	}
+
+	if len(index.ConeSearch(EquatorialCoordinate{}, 10)) != 0 {
+		t.Errorf("expected no matches")
+	}
+
+	if len(index.BoxSearch(0, 10, 0, 10)) != 0 {
+		t.Errorf("expected no matches")
+	}
+}
+
+func TestSkyIndexConeSearchMatchesBruteForce(t *testing.T) {
+	var coordinates = getRandomEquatorialCoordinates(5000)
+
+	var index = NewSkyIndex(coordinates)
+
+	var centres = []EquatorialCoordinate{
+		{RightAscension: 0.5, Declination: 10},
+		{RightAscension: 213.9154, Declination: 19.1825},
+		{RightAscension: 90, Declination: 89.5},
+		{RightAscension: 300, Declination: -45},
+	}
+
+	for _, centre := range centres {
+		for _, radius := range []float64{0.5, 2, 15, 120} {
+			var want = 0
+
+			for _, eq := range coordinates {
+				if GetAngularSeparation(Coordinate{Latitude: centre.Declination, Longitude: centre.RightAscension}, Coordinate{Latitude: eq.Declination, Longitude: eq.RightAscension}) <= radius {
+					want++
+				}
+			}
+
+			var got = index.ConeSearch(centre, radius)
+
+			if len(got) != want {
+				t.Errorf("got %d, wanted %d", len(got), want)
+			}
+
+			for i := 1; i < len(got); i++ {
+				if got[i].Separation < got[i-1].Separation {
+					t.Errorf("expected the matches to be sorted by separation")
+				}
+			}
+		}
+	}
+}
+
+func TestSkyIndexConeSearchArcturusSpica(t *testing.T) {
+	var index = NewSkyIndex([]EquatorialCoordinate{
+		{RightAscension: arcturus.Longitude, Declination: arcturus.Latitude},
+		{RightAscension: spica.Longitude, Declination: spica.Latitude},
+		{RightAscension: denebola.Longitude, Declination: denebola.Latitude},
+	})
+
+	var got = index.ConeSearch(EquatorialCoordinate{RightAscension: arcturus.Longitude, Declination: arcturus.Latitude}, 33)
+
+	if len(got) != 2 || got[0].Index != 0 || got[1].Index != 1 {
+		t.Errorf("got %v, wanted Arcturus and Spica", got)
+		return
+	}
+
+	if math.Abs(got[1].Separation-32.793027) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got[1].Separation, 32.793027)
+	}
+}
+
+func TestSkyIndexBoxSearchMatchesBruteForce(t *testing.T) {
+	var coordinates = getRandomEquatorialCoordinates(5000)
+
+	var index = NewSkyIndex(coordinates)
+
+	var boxes = [][4]float64{
+		{10, 40, -10, 20},
+		{350, 10, -30, 30},
+		{100, 350, 60, 90},
+		{0, 360, -90, -80},
+	}
+
+	for _, box := range boxes {
+		var want = 0
+
+		for _, eq := range coordinates {
+			var Δα = math.Mod(eq.RightAscension-box[0]+360, 360)
+
+			var width = math.Mod(box[1]-box[0]+360, 360)
+
+			if box[1]-box[0] >= 360 {
+				width = 360
+			}
+
+			if Δα <= width && eq.Declination >= box[2] && eq.Declination <= box[3] {
+				want++
+			}
+		}
+
+		var got = index.BoxSearch(box[0], box[1], box[2], box[3])
+
+		if len(got) != want {
+			t.Errorf("%v: got %d, wanted %d", box, len(got), want)
+		}
+	}
+}
+
+func TestSkyIndexBoxSearchFullCircle(t *testing.T) {
+	var coordinates = getRandomEquatorialCoordinates(1000)
+
+	var index = NewSkyIndex(coordinates)
+
+	var got = index.BoxSearch(0, 360, -90, 90)
+
+	if len(got) != len(coordinates) {
+		t.Errorf("got %d, wanted %d", len(got), len(coordinates))
+	}
+
+	if len(index.BoxSearch(-180, 180, -90, 90)) != len(coordinates) {
+		t.Errorf("got %d, wanted %d", len(index.BoxSearch(-180, 180, -90, 90)), len(coordinates))
+	}
+}
+
+func TestSkyIndexSearchAboveAltitude(t *testing.T) {
+	var coordinates = getRandomEquatorialCoordinates(2000)
+
+	var index = NewSkyIndex(coordinates)
+
+	var datetime = time.Date(2021, 5, 14, 8, 0, 0, 0, time.UTC)
+
+	var got = index.SearchAboveAltitude(datetime, longitude, latitude, 30)
+
+	var found = make(map[int]bool)
+
+	for _, m := range got {
+		found[m.Index] = true
+	}
+
+	for i, eq := range coordinates {
+		var hz = ConvertEquatorialCoordinateToHorizontal(datetime, longitude, latitude, ConvertJ2000EquatorialCoordinateToEpochOfDate(datetime, eq))
+
+		// ignore coordinates on the very boundary, where rounding may decide either way:
+		if math.Abs(hz.Altitude-30) < 0.000001 {
+			continue
+		}
+
+		if (hz.Altitude > 30) != found[i] {
+			t.Errorf("got %t, wanted %t for an altitude of %f", found[i], hz.Altitude > 30, hz.Altitude)
+		}
+	}
+}
@@ -0,0 +1,522 @@
+package dusk
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+/*
+	the maximum distance of any point on the face of a sundial from the foot of its nodus, in heights of the nodus
+*/
+var SUNDIAL_MAXIMUM_RADIUS float64 = 5
+
+type SundialCoordinate struct {
+	/*
+		X - the distance to the right of the foot of the nodus, as seen facing the dial
+	*/
+	X float64 `json:"x"`
+	/*
+		Y - the distance above the foot of the nodus, as seen facing the dial
+	*/
+	Y float64 `json:"y"`
+}
+
+type SundialHourLine struct {
+	/*
+		hour of the clock, i.e., of the legal time at the meridian of the dial
+	*/
+	Hour int `json:"hour"`
+	/*
+		angle of the hour line from the line of local apparent noon, at the root of the style (in degrees), positive in
+		the afternoon, and negative in the morning
+	*/
+	Angle float64 `json:"angle"`
+	/*
+		points of the shadow of the nodus on the face of the dial at the hour, i.e., a straight line, or a figure-of-eight
+		when the equation of time is included, as unbroken runs of points split wherever the shadow leaves the face
+	*/
+	Points [][]SundialCoordinate `json:"points"`
+}
+
+type SundialDeclinationCurve struct {
+	/*
+		apparent ecliptic longitude of the Sun, e.g., 90° at the June solstice
+	*/
+	Longitude float64 `json:"longitude"`
+	/*
+		declination of the Sun (in degrees)
+	*/
+	Declination float64 `json:"declination"`
+	/*
+		points of the shadow of the nodus on the face of the dial through the day, as unbroken runs of points split wherever
+		the shadow leaves the face
+	*/
+	Points [][]SundialCoordinate `json:"points"`
+}
+
+type Sundial struct {
+	/*
+		latitude of the dial (in degrees)
+	*/
+	Latitude float64 `json:"latitude"`
+	/*
+		longitude of the dial (in degrees)
+	*/
+	Longitude float64 `json:"longitude"`
+	/*
+		meridian of the legal time of the dial (in degrees), e.g., 0° for GMT, or the longitude for local apparent time
+	*/
+	Meridian float64 `json:"meridian"`
+	/*
+		height of the nodus above the foot, perpendicular to the face of the dial
+	*/
+	Gnomon float64 `json:"gnomon"`
+	/*
+		angle between the polar style and the face of the dial (in degrees)
+	*/
+	StyleHeight float64 `json:"styleHeight"`
+	/*
+		angle of the substyle from the Y axis of the face, positive towards the X axis (in degrees)
+	*/
+	SubstyleAngle float64 `json:"substyleAngle"`
+	/*
+		point at which the polar style meets the face of the dial, through which all hour lines pass, or NaN for a polar dial
+	*/
+	Root SundialCoordinate `json:"root"`
+	/*
+		hour lines of the dial, for each hour of the clock at which the face is in sunlight
+	*/
+	HourLines []SundialHourLine `json:"hourLines"`
+	/*
+		declination curves of the dial, when the Sun enters each sign of the zodiac
+	*/
+	DeclinationCurves []SundialDeclinationCurve `json:"declinationCurves"`
+}
+
+/*
+	the face of a sundial, as the unit vectors (east, north, up) of its normal, and of its X and Y axes
+*/
+type sundialFace struct{ n, u, v [3]float64 }
+
+/*
+	getVectorDotProduct()
+
+	@param a - the first vector
+	@param b - the second vector
+	@returns the dot product a · b
+*/
+func getVectorDotProduct(a [3]float64, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+/*
+	getVectorCrossProduct()
+
+	@param a - the first vector
+	@param b - the second vector
+	@returns the cross product a × b
+*/
+func getVectorCrossProduct(a [3]float64, b [3]float64) [3]float64 {
+	return [3]float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
+
+/*
+	getSundialShadow()
+
+	@param face - the face of the dial
+	@param gnomon - the height of the nodus above the face
+	@param H - the local hour angle of the Sun (in degrees)
+	@param δ - the declination of the Sun (in degrees)
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@returns the shadow of the nodus on the face, and whether the Sun is above both the horizon and the face, and the
+	shadow falls within SUNDIAL_MAXIMUM_RADIUS of the foot of the nodus
+*/
+func getSundialShadow(face sundialFace, gnomon float64, H float64, δ float64, latitude float64) (SundialCoordinate, bool) {
+	var hz = getHorizontalCoordinateFromHourAngle(H, δ, latitude)
+
+	if hz.Altitude <= 0 {
+		return SundialCoordinate{}, false
+	}
+
+	var s = convertHorizontalCoordinateToUnitVector(hz)
+
+	var sn = getVectorDotProduct(s, face.n)
+
+	if sn <= 0 {
+		return SundialCoordinate{}, false
+	}
+
+	// the shadow is where the ray from the Sun through the nodus meets the face, i.e., P = h n - (h / s · n) s:
+	var t = gnomon / sn
+
+	var P = [3]float64{
+		gnomon*face.n[0] - t*s[0],
+		gnomon*face.n[1] - t*s[1],
+		gnomon*face.n[2] - t*s[2],
+	}
+
+	var shadow = SundialCoordinate{
+		X: getVectorDotProduct(P, face.u),
+		Y: getVectorDotProduct(P, face.v),
+	}
+
+	if math.Hypot(shadow.X, shadow.Y) > SUNDIAL_MAXIMUM_RADIUS*gnomon {
+		return shadow, false
+	}
+
+	return shadow, true
+}
+
+/*
+	getSundialRuns()
+
+	@param shadows - the shadows of the nodus on the face, in order
+	@param lit - whether each shadow falls on the face, as given by getSundialShadow()
+	@param closed - whether the last shadow is followed by the first, e.g., through the days of the year
+	@returns the unbroken runs of at least two shadows on the face, so that no line is drawn across a gap
+*/
+func getSundialRuns(shadows []SundialCoordinate, lit []bool, closed bool) [][]SundialCoordinate {
+	var runs = [][]SundialCoordinate{}
+
+	var run = []SundialCoordinate{}
+
+	for i, shadow := range shadows {
+		if lit[i] {
+			run = append(run, shadow)
+			continue
+		}
+
+		runs, run = append(runs, run), []SundialCoordinate{}
+	}
+
+	runs = append(runs, run)
+
+	// the run through the end of a closed curve continues into the run from its start, or returns to its start where the
+	// shadow never leaves the face:
+	switch {
+	case closed && len(runs) > 1:
+		runs[0] = append(runs[len(runs)-1], runs[0]...)
+		runs = runs[:len(runs)-1]
+	case closed && len(run) > 1:
+		runs[0] = append(runs[0], runs[0][0])
+	}
+
+	var unbroken = [][]SundialCoordinate{}
+
+	for _, run := range runs {
+		if len(run) > 1 {
+			unbroken = append(unbroken, run)
+		}
+	}
+
+	return unbroken
+}
+
+/*
+	getSundialHourLineDirection()
+
+	@param face - the face of the dial
+	@param H - the local hour angle of the Sun (in degrees)
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@returns the unit direction (X, Y) of the hour line on the face, away from the root of the style, i.e., the
+	intersection of the face with the hour plane through the polar axis
+*/
+func getSundialHourLineDirection(face sundialFace, H float64, latitude float64) SundialCoordinate {
+	var p = [3]float64{0, cosx(latitude), sinx(latitude)}
+
+	// the direction of the Sun on the celestial equator at the hour angle:
+	var e = convertHorizontalCoordinateToUnitVector(getHorizontalCoordinateFromHourAngle(H, 0, latitude))
+
+	var d = getVectorCrossProduct(getVectorCrossProduct(p, e), face.n)
+
+	// the shadow falls away from the Sun:
+	if getVectorDotProduct(d, e) > 0 {
+		d = [3]float64{-d[0], -d[1], -d[2]}
+	}
+
+	var x, y = getVectorDotProduct(d, face.u), getVectorDotProduct(d, face.v)
+
+	var r = math.Hypot(x, y)
+
+	return SundialCoordinate{X: x / r, Y: y / r}
+}
+
+/*
+	getSundial()
+
+	@param datetime - the datetime of the observer, whose year is used for the equation of time
+	@param face - the face of the dial
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param meridian - the meridian of the legal time of the dial (in degrees), or the longitude for local apparent time
+	@param gnomon - the height of the nodus above the face, e.g., in mm
+	@param equationOfTime - whether to include the equation of time in the hour lines, i.e., to show mean time
+	@returns the hour lines and declination curves of the dial
+*/
+func getSundial(datetime time.Time, face sundialFace, longitude float64, latitude float64, meridian float64, gnomon float64, equationOfTime bool) Sundial {
+	var p = [3]float64{0, cosx(latitude), sinx(latitude)}
+
+	var pn = getVectorDotProduct(p, face.n)
+
+	var sundial = Sundial{
+		Latitude:          latitude,
+		Longitude:         longitude,
+		Meridian:          meridian,
+		Gnomon:            gnomon,
+		StyleHeight:       asinx(math.Abs(pn)),
+		SubstyleAngle:     atan2yx(getVectorDotProduct(p, face.u), getVectorDotProduct(p, face.v)),
+		Root:              SundialCoordinate{X: math.NaN(), Y: math.NaN()},
+		HourLines:         []SundialHourLine{},
+		DeclinationCurves: []SundialDeclinationCurve{},
+	}
+
+	// the polar style meets the face at h n - (h / p · n) p, unless it is parallel to the face:
+	if math.Abs(pn) > 1e-9 {
+		var R = [3]float64{
+			gnomon*face.n[0] - gnomon/pn*p[0],
+			gnomon*face.n[1] - gnomon/pn*p[1],
+			gnomon*face.n[2] - gnomon/pn*p[2],
+		}
+
+		sundial.Root = SundialCoordinate{
+			X: getVectorDotProduct(R, face.u),
+			Y: getVectorDotProduct(R, face.v),
+		}
+	}
+
+	var ε = GetMeanObliquityOfTheEcliptic(GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime))
+
+	var noon = getSundialHourLineDirection(face, 0, latitude)
+
+	for hour := 0; hour < 24; hour++ {
+		// the local hour angle of the mean Sun, corrected for the longitude from the meridian of legal time:
+		var H = math.Mod(15*float64(hour-12)+longitude-meridian+540, 360) - 180
+
+		var direction = getSundialHourLineDirection(face, H, latitude)
+
+		var line = SundialHourLine{
+			Hour:   hour,
+			Angle:  acosx(math.Max(-1, math.Min(1, direction.X*noon.X+direction.Y*noon.Y))),
+			Points: [][]SundialCoordinate{},
+		}
+
+		var shadows, lit = []SundialCoordinate{}, []bool{}
+
+		if H < 0 {
+			line.Angle = -line.Angle
+		}
+
+		if equationOfTime {
+			// the apparent Sun is ahead of the mean Sun by the equation of time, i.e., by 0.25° per minute:
+			for d := time.Date(datetime.Year(), 1, 1, 12, 0, 0, 0, time.UTC); d.Year() == datetime.Year(); d = d.AddDate(0, 0, 1) {
+				var δ = GetApparentSolarEquatorialPosition(d).Declination
+
+				shadow, ok := getSundialShadow(face, gnomon, H+GetEquationOfTime(d)/4, δ, latitude)
+
+				shadows, lit = append(shadows, shadow), append(lit, ok)
+			}
+
+			// the figure-of-eight closes on itself from the end of the year to its start:
+			line.Points = getSundialRuns(shadows, lit, true)
+		} else {
+			for i := 0; i <= 48; i++ {
+				var δ = -ε + float64(i)*ε/24
+
+				shadow, ok := getSundialShadow(face, gnomon, H, δ, latitude)
+
+				shadows, lit = append(shadows, shadow), append(lit, ok)
+			}
+
+			line.Points = getSundialRuns(shadows, lit, false)
+		}
+
+		if len(line.Points) > 0 {
+			sundial.HourLines = append(sundial.HourLines, line)
+		}
+	}
+
+	// the declination curves, as the Sun enters each sign of the zodiac from the December to the June solstice:
+	for λ := -90.0; λ <= 90; λ += 30 {
+		var curve = SundialDeclinationCurve{
+			Longitude:   math.Mod(λ+360, 360),
+			Declination: asinx(sinx(ε) * sinx(λ)),
+			Points:      [][]SundialCoordinate{},
+		}
+
+		var shadows, lit = []SundialCoordinate{}, []bool{}
+
+		for H := -180.0; H < 180; H += 1 {
+			shadow, ok := getSundialShadow(face, gnomon, H, curve.Declination, latitude)
+
+			shadows, lit = append(shadows, shadow), append(lit, ok)
+		}
+
+		// the day closes on itself from the lower culmination at -180° to that at +180°:
+		curve.Points = getSundialRuns(shadows, lit, true)
+
+		if len(curve.Points) > 0 {
+			sundial.DeclinationCurves = append(sundial.DeclinationCurves, curve)
+		}
+	}
+
+	return sundial
+}
+
+/*
+	GetHorizontalSundial()
+
+	@param datetime - the datetime of the observer, whose year is used for the equation of time
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param meridian - the meridian of the legal time of the dial (in degrees), or the longitude for local apparent time
+	@param gnomon - the height of the nodus above the face, e.g., in mm
+	@param equationOfTime - whether to include the equation of time in the hour lines, i.e., to show mean time
+	@returns the horizontal sundial, with the X axis to the east and the Y axis to the north
+	@see ch.58 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetHorizontalSundial(datetime time.Time, longitude float64, latitude float64, meridian float64, gnomon float64, equationOfTime bool) Sundial {
+	var face = sundialFace{
+		n: [3]float64{0, 0, 1},
+		u: [3]float64{1, 0, 0},
+		v: [3]float64{0, 1, 0},
+	}
+
+	return getSundial(datetime, face, longitude, latitude, meridian, gnomon, equationOfTime)
+}
+
+/*
+	GetVerticalSundial()
+
+	@param datetime - the datetime of the observer, whose year is used for the equation of time
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param meridian - the meridian of the legal time of the dial (in degrees), or the longitude for local apparent time
+	@param orientation - the azimuth the face of the wall looks towards (in degrees), e.g., 180° for a direct south dial
+	@param gnomon - the height of the nodus above the face, e.g., in mm
+	@param equationOfTime - whether to include the equation of time in the hour lines, i.e., to show mean time
+	@returns the vertical sundial, with the X axis to the right (as seen facing the wall) and the Y axis upwards
+	@see ch.58 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetVerticalSundial(datetime time.Time, longitude float64, latitude float64, meridian float64, orientation float64, gnomon float64, equationOfTime bool) Sundial {
+	var face = sundialFace{
+		n: [3]float64{sinx(orientation), cosx(orientation), 0},
+		u: [3]float64{-cosx(orientation), sinx(orientation), 0},
+		v: [3]float64{0, 0, 1},
+	}
+
+	return getSundial(datetime, face, longitude, latitude, meridian, gnomon, equationOfTime)
+}
+
+/*
+	GetEquatorialSundial()
+
+	N.B. the face looks towards the elevated pole, and so is only in sunlight from the spring to the autumn equinox.
+
+	@param datetime - the datetime of the observer, whose year is used for the equation of time
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param meridian - the meridian of the legal time of the dial (in degrees), or the longitude for local apparent time
+	@param gnomon - the height of the nodus above the face, e.g., in mm
+	@param equationOfTime - whether to include the equation of time in the hour lines, i.e., to show mean time
+	@returns the equatorial sundial, with the X axis to the east and the Y axis down the face away from the pole
+*/
+func GetEquatorialSundial(datetime time.Time, longitude float64, latitude float64, meridian float64, gnomon float64, equationOfTime bool) Sundial {
+	var sign float64 = 1
+
+	if latitude < 0 {
+		sign = -1
+	}
+
+	var n = [3]float64{0, sign * cosx(latitude), sign * sinx(latitude)}
+
+	var u = [3]float64{1, 0, 0}
+
+	var face = sundialFace{
+		n: n,
+		u: u,
+		v: getVectorCrossProduct(n, u),
+	}
+
+	return getSundial(datetime, face, longitude, latitude, meridian, gnomon, equationOfTime)
+}
+
+/*
+	getSundialPolyline()
+
+	@param runs - the unbroken runs of points on the face of the dial
+	@param class - the class of the polyline, e.g., "hour"
+	@returns an SVG polyline for each run of points, with the Y axis flipped downwards, so that no line is drawn across a gap
+*/
+func getSundialPolyline(runs [][]SundialCoordinate, class string) string {
+	var b strings.Builder
+
+	for _, points := range runs {
+		var coordinates = make([]string, len(points))
+
+		for i, point := range points {
+			coordinates[i] = fmt.Sprintf("%.3f,%.3f", point.X, -point.Y)
+		}
+
+		fmt.Fprintf(&b, "<polyline class=\"%s\" fill=\"none\" points=\"%s\"/>\n", class, strings.Join(coordinates, " "))
+	}
+
+	return b.String()
+}
+
+/*
+	SVG()
+
+	@returns the face of the sundial as a standalone SVG document to scale, in mm when the gnomon is measured in mm, centred
+	on the foot of the nodus
+*/
+func (s Sundial) SVG() string {
+	var R = SUNDIAL_MAXIMUM_RADIUS * s.Gnomon
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%gmm\" height=\"%gmm\" viewBox=\"%g %g %g %g\">\n", 2*R, 2*R, -R, -R, 2*R, 2*R)
+
+	fmt.Fprintf(&b, "<g stroke=\"#999999\" stroke-width=\"%g\">\n", R/400)
+
+	for _, curve := range s.DeclinationCurves {
+		b.WriteString(getSundialPolyline(curve.Points, "declination"))
+	}
+
+	fmt.Fprintf(&b, "</g>\n<g stroke=\"#000000\" stroke-width=\"%g\">\n", R/200)
+
+	for _, line := range s.HourLines {
+		b.WriteString(getSundialPolyline(line.Points, "hour"))
+	}
+
+	// the substyle, from the root of the style to the foot of the nodus:
+	if !math.IsNaN(s.Root.X) {
+		fmt.Fprintf(&b, "<line class=\"substyle\" x1=\"%.3f\" y1=\"%.3f\" x2=\"0\" y2=\"0\" stroke-dasharray=\"%g\"/>\n", s.Root.X, -s.Root.Y, R/50)
+	}
+
+	fmt.Fprintf(&b, "</g>\n<circle class=\"nodus\" cx=\"0\" cy=\"0\" r=\"%g\"/>\n", R/100)
+
+	// label each hour line at its point furthest from the foot of the nodus:
+	for _, line := range s.HourLines {
+		var label = line.Points[0][0]
+
+		for _, run := range line.Points {
+			for _, point := range run {
+				if math.Hypot(point.X, point.Y) > math.Hypot(label.X, label.Y) {
+					label = point
+				}
+			}
+		}
+
+		fmt.Fprintf(&b, "<text x=\"%.3f\" y=\"%.3f\" text-anchor=\"middle\" font-size=\"%g\">%d</text>\n", label.X, -label.Y, R/20, line.Hour)
+	}
+
+	b.WriteString("</svg>\n")
+
+	return b.String()
+}
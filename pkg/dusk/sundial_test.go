@@ -0,0 +1,222 @@
+package dusk
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetHorizontalSundial(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var got = GetHorizontalSundial(datetime, 0, 51.5, 0, 100, false)
+
+	if math.Abs(got.StyleHeight-51.5) > 1e-9 {
+		t.Errorf("got %f, wanted %f", got.StyleHeight, 51.5)
+	}
+
+	// the style meets the face h / tan(φ) to the south of the foot of the nodus:
+	if math.Abs(got.Root.X) > 1e-9 || math.Abs(got.Root.Y+100/tanx(51.5)) > 1e-9 {
+		t.Errorf("got %v, wanted %v", got.Root, SundialCoordinate{X: 0, Y: -100 / tanx(51.5)})
+	}
+
+	if len(got.HourLines) != 13 || got.HourLines[0].Hour != 6 {
+		t.Errorf("got %d hour lines, wanted 13 from 06:00 to 18:00", len(got.HourLines))
+	}
+
+	for _, line := range got.HourLines {
+		// tan(θ) = sin(φ) tan(H):
+		var want = math.Atan(sinx(51.5)*tanx(15*float64(line.Hour-12))) * 180 / math.Pi
+
+		if math.Abs(line.Angle-want) > 1e-6 {
+			t.Errorf("got %f, wanted %f for %d:00", line.Angle, want, line.Hour)
+		}
+	}
+
+	if len(got.DeclinationCurves) != 7 {
+		t.Errorf("got %d, wanted %d", len(got.DeclinationCurves), 7)
+	}
+}
+
+func TestGetHorizontalSundialLongitudeCorrection(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// ~7.5° west of the meridian, i.e., local apparent noon is ~30 minutes after 12:00 on the clock:
+	var got = GetHorizontalSundial(datetime, -7.5, 51.5, 0, 100, false)
+
+	for _, line := range got.HourLines {
+		if line.Hour != 15 {
+			continue
+		}
+
+		var want = math.Atan(sinx(51.5)*tanx(37.5)) * 180 / math.Pi
+
+		if math.Abs(line.Angle-want) > 1e-6 {
+			t.Errorf("got %f, wanted %f", line.Angle, want)
+		}
+	}
+}
+
+func TestGetHorizontalSundialEquationOfTime(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var got = GetHorizontalSundial(datetime, 0, 51.5, 0, 100, true)
+
+	for _, line := range got.HourLines {
+		if line.Hour != 12 {
+			continue
+		}
+
+		var east, west float64 = 0, 0
+
+		for _, run := range line.Points {
+			for _, point := range run {
+				east, west = math.Max(east, point.X), math.Min(west, point.X)
+			}
+		}
+
+		// the equation of time ranges from ~-14 to ~+16 minutes, so the mean noon line is a figure-of-eight:
+		if east <= 0 || west >= 0 {
+			t.Errorf("got %f to %f, wanted a figure-of-eight about the noon line", west, east)
+		}
+	}
+}
+
+func TestGetHorizontalSundialEquationOfTimeEarlyMorning(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// the Sun is only up at 06:00 from the September to the March equinox in the south, i.e., across the end of the year:
+	var got = GetHorizontalSundial(datetime, 0, -51.5, 0, 100, true)
+
+	for _, line := range got.HourLines {
+		if line.Hour != 6 {
+			continue
+		}
+
+		// the run from September to December continues into that from January to March, without crossing the winter:
+		if len(line.Points) != 1 {
+			t.Fatalf("got %d runs, wanted %d", len(line.Points), 1)
+		}
+
+		for i, run := 1, line.Points[0]; i < len(run); i++ {
+			if d := math.Hypot(run[i].X-run[i-1].X, run[i].Y-run[i-1].Y); d > 20 {
+				t.Errorf("got a step of %f, wanted the shadow to move less than %f per day", d, 20.0)
+			}
+		}
+
+		if n := strings.Count(getSundialPolyline(line.Points, "hour"), "<polyline"); n != 1 {
+			t.Errorf("got %d, wanted %d", n, 1)
+		}
+
+		return
+	}
+
+	t.Errorf("got no hour line for 06:00")
+}
+
+func TestGetSundialRuns(t *testing.T) {
+	var shadows = []SundialCoordinate{{X: 0}, {X: 1}, {X: 2}, {X: 3}, {X: 4}, {X: 5}, {X: 6}}
+
+	var lit = []bool{true, true, false, true, true, false, true}
+
+	// the gaps split the shadows into runs, and the lone shadow before the end is dropped:
+	if got := getSundialRuns(shadows, lit, false); len(got) != 2 || len(got[0]) != 2 || len(got[1]) != 2 {
+		t.Errorf("got %v, wanted two runs of two shadows", got)
+	}
+
+	// the lone shadow at the end of a closed curve continues into the run from its start:
+	if got := getSundialRuns(shadows, lit, true); len(got) != 2 || len(got[0]) != 3 || got[0][0].X != 6 {
+		t.Errorf("got %v, wanted the last shadow joined to the first run", got)
+	}
+}
+
+func TestGetVerticalSundial(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var got = GetVerticalSundial(datetime, 0, 51.5, 0, 180, 100, false)
+
+	if math.Abs(got.StyleHeight-38.5) > 1e-9 {
+		t.Errorf("got %f, wanted %f", got.StyleHeight, 38.5)
+	}
+
+	for _, line := range got.HourLines {
+		// tan(θ) = cos(φ) tan(H):
+		var want = math.Atan(cosx(51.5)*tanx(15*float64(line.Hour-12))) * 180 / math.Pi
+
+		if math.Abs(line.Angle-want) > 1e-6 {
+			t.Errorf("got %f, wanted %f for %d:00", line.Angle, want, line.Hour)
+		}
+	}
+}
+
+func TestGetVerticalSundialDeclining(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// a wall declining 20° west of south:
+	var got = GetVerticalSundial(datetime, 0, 51.5, 0, 200, 100, false)
+
+	for _, line := range got.HourLines {
+		if line.Hour != 15 {
+			continue
+		}
+
+		// tan(θ) = cos(φ) / (cos(D) cot(H) + sin(φ) sin(D)):
+		var want = math.Atan(cosx(51.5)/(cosx(20)/tanx(45)+sinx(51.5)*sinx(20))) * 180 / math.Pi
+
+		if math.Abs(line.Angle-want) > 1e-6 {
+			t.Errorf("got %f, wanted %f", line.Angle, want)
+		}
+	}
+}
+
+func TestGetEquatorialSundial(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var got = GetEquatorialSundial(datetime, 0, 51.5, 0, 100, false)
+
+	if math.Abs(got.StyleHeight-90) > 1e-9 {
+		t.Errorf("got %f, wanted %f", got.StyleHeight, 90.0)
+	}
+
+	for _, line := range got.HourLines {
+		// the hour lines are evenly spaced at 15° per hour:
+		var want = 15 * float64(line.Hour-12)
+
+		if math.Abs(line.Angle-want) > 1e-6 {
+			t.Errorf("got %f, wanted %f for %d:00", line.Angle, want, line.Hour)
+		}
+	}
+
+	// the face is only in sunlight at the equinox and above, i.e., the June solstice and the signs either side of it:
+	if len(got.DeclinationCurves) != 3 {
+		t.Errorf("got %d, wanted %d", len(got.DeclinationCurves), 3)
+	}
+}
+
+func TestSundialSVG(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var got = GetHorizontalSundial(datetime, 0, 51.5, 0, 100, false)
+
+	var svg = got.SVG()
+
+	if !strings.HasPrefix(svg, "<svg") || !strings.Contains(svg, "width=\"1000mm\"") {
+		t.Errorf("got %q, wanted an SVG document 1000mm wide", svg)
+	}
+
+	var runs = 0
+
+	for _, line := range got.HourLines {
+		runs += len(line.Points)
+	}
+
+	for _, curve := range got.DeclinationCurves {
+		runs += len(curve.Points)
+	}
+
+	// a polyline for each unbroken run of each hour line and declination curve:
+	if n := strings.Count(svg, "<polyline"); n != runs {
+		t.Errorf("got %d, wanted %d", n, runs)
+	}
+}
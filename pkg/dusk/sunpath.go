@@ -0,0 +1,297 @@
+package dusk
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	tzm "github.com/zsefvlol/timezonemapper"
+)
+
+type SunPath struct {
+	/*
+		date of the daily track of the Sun, in localtime
+	*/
+	Date time.Time `json:"date"`
+	/*
+		positions of the Sun above the horizon, every 10 minutes of the day
+	*/
+	Track []TemporalHorizontalCoordinate `json:"track"`
+}
+
+type SunPathHour struct {
+	/*
+		hour of the clock, in the local standard time (i.e., without daylight saving time)
+	*/
+	Hour int `json:"hour"`
+	/*
+		positions of the Sun above the horizon at the hour, every day of the year
+	*/
+	Track []TemporalHorizontalCoordinate `json:"track"`
+}
+
+type SunPathDiagram struct {
+	/*
+		daily tracks of the Sun, at the equinoxes, the solstices and any chosen dates
+	*/
+	Days []SunPath `json:"days"`
+	/*
+		hourly tracks of the Sun, i.e., the analemma of each hour of the clock in the local standard time
+	*/
+	Hours []SunPathHour `json:"hours"`
+}
+
+/*
+	GetEquinoxesAndSolsticesInUTC()
+
+	@param datetime - the datetime of the observer, whose year is used
+	@returns the March equinox, the June solstice, the September equinox and the December solstice of the year (in UTC),
+	i.e., when the apparent longitude of the Sun is a multiple of 90°
+	@see ch.27 p.169 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetEquinoxesAndSolsticesInUTC(datetime time.Time) [4]time.Time {
+	var seasons [4]time.Time
+
+	for k := range seasons {
+		// the first estimate, from the mean length of the tropical year:
+		var t = time.Date(datetime.Year(), 3, 20, 0, 0, 0, 0, time.UTC).Add(time.Duration(float64(k) * 365.2422 / 4 * 24 * float64(time.Hour)))
+
+		for i := 0; i < 10; i++ {
+			var λ = GetApparentSolarEclipticPosition(t).Longitude
+
+			// the correction (eq.27.1), where the Sun advances ~1° per 1.0146 days:
+			var Δ = 58 * sinx(float64(k)*90-λ) * 24 * float64(time.Hour)
+
+			t = t.Add(time.Duration(Δ))
+
+			if math.Abs(Δ) < float64(time.Second) {
+				break
+			}
+		}
+
+		seasons[k] = t
+	}
+
+	return seasons
+}
+
+/*
+	GetAnalemma()
+
+	N.B. the clock time is kept on every day, and so the analemma jumps by an hour at any daylight saving transitions of
+	the location; for a continuous analemma, give the datetime in a fixed zone, e.g., that of getStandardTimeLocation().
+
+	@param datetime - the datetime of the observer, whose clock time (in its location) and year are used
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@returns the geometric horizontal coordinates of the Sun at the same clock time on every day of the year
+*/
+func GetAnalemma(datetime time.Time, longitude float64, latitude float64) []TemporalHorizontalCoordinate {
+	var analemma = []TemporalHorizontalCoordinate{}
+
+	for d := time.Date(datetime.Year(), 1, 1, datetime.Hour(), datetime.Minute(), datetime.Second(), datetime.Nanosecond(), datetime.Location()); d.Year() == datetime.Year(); d = d.AddDate(0, 0, 1) {
+		var hz = GetSolarHorizontalPosition(d.UTC(), longitude, latitude)
+
+		analemma = append(analemma, TemporalHorizontalCoordinate{
+			Datetime: d,
+			Altitude: hz.Altitude,
+			Azimuth:  hz.Azimuth,
+		})
+	}
+
+	return analemma
+}
+
+/*
+	getStandardTimeLocation()
+
+	@param location - the timezone of the observer
+	@param year - the year for which the standard time is found
+	@returns a fixed timezone at the standard time of the location, i.e., the lesser of its offsets in January and July,
+	which excludes any daylight saving time in either hemisphere
+*/
+func getStandardTimeLocation(location *time.Location, year int) *time.Location {
+	name, offset := time.Date(year, 1, 1, 12, 0, 0, 0, location).Zone()
+
+	if n, o := time.Date(year, 7, 1, 12, 0, 0, 0, location).Zone(); o < offset {
+		name, offset = n, o
+	}
+
+	return time.FixedZone(name, offset)
+}
+
+/*
+	getSunPath()
+
+	@param date - the date of the track, in localtime
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@returns the daily track of the Sun above the horizon, every 10 minutes from local midnight
+*/
+func getSunPath(date time.Time, longitude float64, latitude float64) SunPath {
+	var midnight = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+
+	var path = SunPath{
+		Date:  midnight,
+		Track: []TemporalHorizontalCoordinate{},
+	}
+
+	for d := midnight; d.Before(midnight.AddDate(0, 0, 1)); d = d.Add(10 * time.Minute) {
+		var hz = GetSolarHorizontalPosition(d.UTC(), longitude, latitude)
+
+		if hz.Altitude < 0 {
+			continue
+		}
+
+		path.Track = append(path.Track, TemporalHorizontalCoordinate{
+			Datetime: d,
+			Altitude: hz.Altitude,
+			Azimuth:  hz.Azimuth,
+		})
+	}
+
+	return path
+}
+
+/*
+	GetSunPathDiagram()
+
+	@param datetime - the datetime of the observer, whose year is used
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param dates - any further dates for which to include the daily track of the Sun
+	@returns the daily tracks of the Sun at the equinoxes, solstices and chosen dates in the local timezone of the observer,
+	and the hourly tracks of the Sun over the year for each hour of the local standard time at which it is above the
+	horizon, so that they are continuous across any daylight saving transitions
+*/
+func GetSunPathDiagram(datetime time.Time, longitude float64, latitude float64, dates []time.Time) (*SunPathDiagram, error) {
+	// get the corresponding timezone for the longitude and latitude provided:
+	timezone := tzm.LatLngToTimezoneString(latitude, longitude)
+
+	location, err := time.LoadLocation(timezone)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var diagram = SunPathDiagram{
+		Days:  []SunPath{},
+		Hours: []SunPathHour{},
+	}
+
+	var seasons = GetEquinoxesAndSolsticesInUTC(datetime)
+
+	for _, date := range append(seasons[:], dates...) {
+		diagram.Days = append(diagram.Days, getSunPath(date.In(location), longitude, latitude))
+	}
+
+	var standard = getStandardTimeLocation(location, datetime.Year())
+
+	for hour := 0; hour < 24; hour++ {
+		var track = []TemporalHorizontalCoordinate{}
+
+		for _, hz := range GetAnalemma(time.Date(datetime.Year(), 1, 1, hour, 0, 0, 0, standard), longitude, latitude) {
+			if hz.Altitude >= 0 {
+				track = append(track, hz)
+			}
+		}
+
+		if len(track) > 0 {
+			diagram.Hours = append(diagram.Hours, SunPathHour{
+				Hour:  hour,
+				Track: track,
+			})
+		}
+	}
+
+	return &diagram, nil
+}
+
+/*
+	getSunPathDiagramPoint()
+
+	@param altitude - the altitude of the object (in degrees)
+	@param azimuth - the azimuth of the object, measured eastwards from north (in degrees)
+	@param size - the width and height of the diagram
+	@returns the point of the object on the polar diagram, with the zenith at the centre, the horizon at
+	the edge and north at the top
+*/
+func getSunPathDiagramPoint(altitude float64, azimuth float64, size float64) (float64, float64) {
+	var r = (90 - altitude) / 90 * (size/2 - 20)
+
+	return size/2 + r*sinx(azimuth), size/2 - r*cosx(azimuth)
+}
+
+/*
+	writeSunPathDiagramTrack()
+
+	@param b - the builder of the SVG document
+	@param track - the horizontal coordinates of the track
+	@param step - the interval between consecutive positions of the track, beyond which positions below the horizon were dropped
+	@param size - the width and height of the diagram
+	@param class - the class of the polyline, e.g., "day"
+*/
+func writeSunPathDiagramTrack(b *strings.Builder, track []TemporalHorizontalCoordinate, step time.Duration, size float64, class string) {
+	var points = []string{}
+
+	for i, hz := range track {
+		// the track is split into separate polylines where the Sun was below the horizon, rather than joined across the gap:
+		if i > 0 && hz.Datetime.Sub(track[i-1].Datetime) > step {
+			fmt.Fprintf(b, "<polyline class=\"%s\" fill=\"none\" points=\"%s\"/>\n", class, strings.Join(points, " "))
+
+			points = []string{}
+		}
+
+		x, y := getSunPathDiagramPoint(hz.Altitude, hz.Azimuth, size)
+
+		points = append(points, fmt.Sprintf("%.2f,%.2f", x, y))
+	}
+
+	fmt.Fprintf(b, "<polyline class=\"%s\" fill=\"none\" points=\"%s\"/>\n", class, strings.Join(points, " "))
+}
+
+/*
+	SVG()
+
+	N.B. an analemma may be rendered as the only hourly track of an otherwise empty SunPathDiagram.
+
+	@param size - the width and height of the diagram (in pixels)
+	@returns the sun-path diagram as a standalone SVG document, as a polar plot of altitude and azimuth with north at the top
+*/
+func (d SunPathDiagram) SVG(size float64) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%g\" height=\"%g\" viewBox=\"0 0 %g %g\">\n", size, size, size, size)
+
+	b.WriteString("<g stroke=\"#999999\" stroke-width=\"0.5\" fill=\"none\">\n")
+
+	// the circles of altitude, every 15° from the horizon:
+	for altitude := 0.0; altitude < 90; altitude += 15 {
+		fmt.Fprintf(&b, "<circle cx=\"%g\" cy=\"%g\" r=\"%.2f\"/>\n", size/2, size/2, (90-altitude)/90*(size/2-20))
+	}
+
+	b.WriteString("</g>\n")
+
+	for i, cardinal := range []string{"N", "E", "S", "W"} {
+		x, y := getSunPathDiagramPoint(-10, float64(i)*90, size)
+
+		fmt.Fprintf(&b, "<text x=\"%.2f\" y=\"%.2f\" text-anchor=\"middle\" dominant-baseline=\"middle\" font-size=\"12\">%s</text>\n", x, y, cardinal)
+	}
+
+	b.WriteString("<g stroke=\"#4a90d9\" stroke-width=\"0.75\">\n")
+
+	for _, hour := range d.Hours {
+		writeSunPathDiagramTrack(&b, hour.Track, 24*time.Hour, size, "hour")
+	}
+
+	b.WriteString("</g>\n<g stroke=\"#e8a33d\" stroke-width=\"1.5\">\n")
+
+	for _, day := range d.Days {
+		writeSunPathDiagramTrack(&b, day.Track, 10*time.Minute, size, "day")
+	}
+
+	b.WriteString("</g>\n</svg>\n")
+
+	return b.String()
+}
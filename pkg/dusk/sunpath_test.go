@@ -0,0 +1,187 @@
+package dusk
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetEquinoxesAndSolsticesInUTC(t *testing.T) {
+	var got = GetEquinoxesAndSolsticesInUTC(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	var want = [4]time.Time{
+		time.Date(2021, 3, 20, 9, 37, 0, 0, time.UTC),
+		time.Date(2021, 6, 21, 3, 32, 0, 0, time.UTC),
+		time.Date(2021, 9, 22, 19, 21, 0, 0, time.UTC),
+		time.Date(2021, 12, 21, 15, 59, 0, 0, time.UTC),
+	}
+
+	for i := range want {
+		if math.Abs(got[i].Sub(want[i]).Minutes()) > 2 {
+			t.Errorf("got %q, wanted %q", got[i], want[i])
+		}
+	}
+}
+
+func TestGetEquinoxesAndSolsticesInUTCMeeus(t *testing.T) {
+	var got = GetEquinoxesAndSolsticesInUTC(time.Date(1962, 1, 1, 0, 0, 0, 0, time.UTC))[1]
+
+	// the June solstice of 1962 is at 21:24:42 TD, i.e., ~21:24:08 UT (ex.27.a p.170 of Meeus):
+	var want = time.Date(1962, 6, 21, 21, 24, 8, 0, time.UTC)
+
+	if math.Abs(got.Sub(want).Minutes()) > 2 {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestGetAnalemma(t *testing.T) {
+	location, err := time.LoadLocation("Pacific/Honolulu")
+
+	if err != nil {
+		t.Errorf("got %q", err)
+		return
+	}
+
+	var datetime time.Time = time.Date(2021, 5, 14, 12, 0, 0, 0, location)
+
+	var got = GetAnalemma(datetime, longitude, latitude)
+
+	if len(got) != 365 {
+		t.Errorf("got %d, wanted %d", len(got), 365)
+	}
+
+	var lowest, highest = got[0], got[0]
+
+	for _, hz := range got {
+		if hz.Datetime.Hour() != 12 {
+			t.Errorf("got %q, wanted 12:00", hz.Datetime)
+		}
+
+		if hz.Altitude < lowest.Altitude {
+			lowest = hz
+		}
+
+		if hz.Altitude > highest.Altitude {
+			highest = hz
+		}
+	}
+
+	// at noon, the Sun is lowest in December, i.e., at an altitude of ~90° - 19.8° - 23.4°:
+	if lowest.Datetime.Month() != time.December || math.Abs(lowest.Altitude-46.5) > 2 {
+		t.Errorf("got %v, wanted ~46.5° in December", lowest)
+	}
+
+	// and transits ~1° south of the zenith in May and July:
+	if highest.Altitude < 85 {
+		t.Errorf("got %v, wanted > 85°", highest)
+	}
+}
+
+func TestGetSunPathDiagram(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC)
+
+	var chosen = time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC)
+
+	got, err := GetSunPathDiagram(datetime, longitude, latitude, []time.Time{chosen})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+		return
+	}
+
+	if len(got.Days) != 5 {
+		t.Errorf("got %d, wanted %d", len(got.Days), 5)
+	}
+
+	for _, day := range got.Days {
+		if len(day.Track) == 0 {
+			t.Errorf("got an empty track on %q", day.Date)
+		}
+
+		for _, hz := range day.Track {
+			if hz.Altitude < 0 {
+				t.Errorf("got %f, wanted >= 0", hz.Altitude)
+			}
+		}
+	}
+
+	// the June solstice track is longer than the December solstice track in the northern hemisphere:
+	if len(got.Days[1].Track) <= len(got.Days[3].Track) {
+		t.Errorf("got %d, wanted > %d", len(got.Days[1].Track), len(got.Days[3].Track))
+	}
+
+	// the Sun is above the horizon at some time of the year from 06:00 to 19:00 HST:
+	if len(got.Hours) != 14 || got.Hours[0].Hour != 6 || got.Hours[len(got.Hours)-1].Hour != 19 {
+		t.Errorf("got %d hourly tracks, wanted 14 from 06:00 to 19:00", len(got.Hours))
+	}
+
+	var svg = got.SVG(400)
+
+	if !strings.HasPrefix(svg, "<svg") || !strings.HasSuffix(svg, "</svg>\n") {
+		t.Errorf("got %q, wanted an SVG document", svg)
+	}
+
+	if n := strings.Count(svg, "<polyline"); n != len(got.Days)+len(got.Hours) {
+		t.Errorf("got %d, wanted %d", n, len(got.Days)+len(got.Hours))
+	}
+}
+
+func TestGetSunPathDiagramDaylightSavingTime(t *testing.T) {
+	// London observes British Summer Time from 28 March to 31 October 2021:
+	got, err := GetSunPathDiagram(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), -0.1278, 51.5074, nil)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+		return
+	}
+
+	for _, hour := range got.Hours {
+		for i, hz := range hour.Track {
+			// the hourly tracks are in Greenwich Mean Time all year:
+			if _, offset := hz.Datetime.Zone(); offset != 0 || hz.Datetime.Hour() != hour.Hour {
+				t.Errorf("got %q, wanted %02d:00 GMT", hz.Datetime, hour.Hour)
+			}
+
+			if i == 0 || hz.Datetime.Sub(hour.Track[i-1].Datetime) > 24*time.Hour {
+				continue
+			}
+
+			// the Sun moves by less than ~0.5° a day at the same hour, and so does not jump at the daylight saving transitions:
+			var previous = hour.Track[i-1]
+
+			if s := GetAngularSeparation(Coordinate{Latitude: previous.Altitude, Longitude: previous.Azimuth}, Coordinate{Latitude: hz.Altitude, Longitude: hz.Azimuth}); s > 2 {
+				t.Errorf("got a jump of %f° from %q to %q", s, previous.Datetime, hz.Datetime)
+			}
+		}
+	}
+}
+
+func TestSunPathDiagramSVGSegments(t *testing.T) {
+	// at Sydney, the Sun is above the horizon at 05:00 AEST from about October until February, i.e., at either end of the year:
+	got, err := GetSunPathDiagram(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 151.2093, -33.8688, nil)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+		return
+	}
+
+	var gaps = 0
+
+	for _, hour := range got.Hours {
+		for i := 1; i < len(hour.Track); i++ {
+			if hour.Track[i].Datetime.Sub(hour.Track[i-1].Datetime) > 24*time.Hour {
+				gaps++
+			}
+		}
+	}
+
+	if gaps == 0 {
+		t.Errorf("got %d, wanted an hourly track with a gap", gaps)
+	}
+
+	// each gap in a track splits its polyline, rather than joining the positions either side of the gap:
+	if n := strings.Count(got.SVG(400), "<polyline"); n != len(got.Days)+len(got.Hours)+gaps {
+		t.Errorf("got %d, wanted %d", n, len(got.Days)+len(got.Hours)+gaps)
+	}
+}
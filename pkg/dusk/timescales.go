@@ -0,0 +1,484 @@
+package dusk
+
+import (
+	"math"
+	"time"
+)
+
+// the constant offset between Terrestrial Time (TT) and International Atomic Time (TAI), in seconds:
+var TT_MINUS_TAI float64 = 32.184
+
+/*
+	An entry of the table of the difference between International Atomic Time (TAI) and Coordinated Universal Time (UTC),
+	where TAI - UTC = offset + (MJD - epoch) × rate seconds, from the Julian date JD (in UTC) onwards.
+
+	Before 1972 the UTC second was not the SI second, and so the offset drifted at a given rate; from 1972 onwards the
+	rate is zero and the offset changes by whole leap seconds.
+
+	@see https://maia.usno.navy.mil/ser7/tai-utc.dat
+*/
+type leapSecond struct {
+	JD     float64
+	offset float64
+	epoch  float64
+	rate   float64
+}
+
+/*
+	the expiry of the leap-second table, i.e., the date up to which IERS Bulletin C has announced that no further leap
+	second will be introduced; beyond it ΔT is extrapolated, and it should be updated along with the table.
+
+	@see https://hpiers.obspm.fr/iers/bul/bulc/ntp/leap-seconds.list
+*/
+var LEAP_SECONDS_EXPIRY time.Time = time.Date(2026, 6, 28, 0, 0, 0, 0, time.UTC)
+
+var leapSeconds = [...]leapSecond{
+	{2437300.5, 1.4228180, 37300, 0.001296},
+	{2437512.5, 1.3728180, 37300, 0.001296},
+	{2437665.5, 1.8458580, 37665, 0.0011232},
+	{2438334.5, 1.9458580, 37665, 0.0011232},
+	{2438395.5, 3.2401300, 38761, 0.001296},
+	{2438486.5, 3.3401300, 38761, 0.001296},
+	{2438639.5, 3.4401300, 38761, 0.001296},
+	{2438761.5, 3.5401300, 38761, 0.001296},
+	{2438820.5, 3.6401300, 38761, 0.001296},
+	{2438942.5, 3.7401300, 38761, 0.001296},
+	{2439004.5, 3.8401300, 38761, 0.001296},
+	{2439126.5, 4.3131700, 39126, 0.002592},
+	{2439887.5, 4.2131700, 39126, 0.002592},
+	// 1 January 1972:
+	{2441317.5, 10, 0, 0},
+	{2441499.5, 11, 0, 0},
+	{2441683.5, 12, 0, 0},
+	{2442048.5, 13, 0, 0},
+	{2442413.5, 14, 0, 0},
+	{2442778.5, 15, 0, 0},
+	{2443144.5, 16, 0, 0},
+	{2443509.5, 17, 0, 0},
+	{2443874.5, 18, 0, 0},
+	{2444239.5, 19, 0, 0},
+	{2444786.5, 20, 0, 0},
+	{2445151.5, 21, 0, 0},
+	{2445516.5, 22, 0, 0},
+	{2446247.5, 23, 0, 0},
+	{2447161.5, 24, 0, 0},
+	{2447892.5, 25, 0, 0},
+	{2448257.5, 26, 0, 0},
+	{2448804.5, 27, 0, 0},
+	{2449169.5, 28, 0, 0},
+	{2449534.5, 29, 0, 0},
+	{2450083.5, 30, 0, 0},
+	{2450630.5, 31, 0, 0},
+	{2451179.5, 32, 0, 0},
+	{2453736.5, 33, 0, 0},
+	{2454832.5, 34, 0, 0},
+	{2456109.5, 35, 0, 0},
+	{2457204.5, 36, 0, 0},
+	// 1 January 2017:
+	{2457754.5, 37, 0, 0},
+}
+
+/*
+	IsCoordinatedUniversalTime()
+
+	N.B. UTC was introduced on 1 January 1961; before then a time.Time is taken to be a reading of Universal Time (UT1).
+
+	@param datetime - the datetime of the observer
+	@returns whether the datetime falls within the era of Coordinated Universal Time (UTC)
+*/
+func IsCoordinatedUniversalTime(datetime time.Time) bool {
+	return GetJulianDate(datetime) >= leapSeconds[0].JD
+}
+
+/*
+	GetTAIMinusUTC()
+
+	N.B. no leap seconds are known beyond the end of the table, so the last value is held for all later dates (although
+	GetDeltaT() is extrapolated beyond LEAP_SECONDS_EXPIRY).
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the difference TAI - UTC (in seconds), or zero before the introduction of UTC
+	@see https://maia.usno.navy.mil/ser7/tai-utc.dat
+*/
+func GetTAIMinusUTC(datetime time.Time) float64 {
+	var JD = GetJulianDate(datetime)
+
+	for i := len(leapSeconds) - 1; i >= 0; i-- {
+		var ls = leapSeconds[i]
+
+		if JD >= ls.JD {
+			// the modified Julian date:
+			var MJD = JD - 2400000.5
+
+			return ls.offset + (MJD-ls.epoch)*ls.rate
+		}
+	}
+
+	return 0
+}
+
+/*
+	GetUT1MinusUTC()
+
+	N.B. the difference is interpolated from the Earth orientation parameters set by SetEarthOrientationTable(). UTC is
+	kept within 0.9 seconds of UT1 by the insertion of leap seconds, and so where the datetime lies outside of the table
+	(or no table is set) the difference falls back to zero.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the difference UT1 - UTC, or DUT1 (in seconds)
+*/
+func GetUT1MinusUTC(datetime time.Time) float64 {
+	if !IsCoordinatedUniversalTime(datetime) {
+		return 0
+	}
+
+	eop, err := GetEarthOrientationParameters(datetime)
+
+	if err != nil {
+		return 0
+	}
+
+	return eop.UT1MinusUTC
+}
+
+/*
+	GetDecimalYear()
+
+	@param datetime - the datetime of the observer
+	@returns the year as a decimal, e.g., 2021.3644 for 14 May 2021 00:00:00 UTC
+*/
+func GetDecimalYear(datetime time.Time) float64 {
+	var y = datetime.UTC().Year()
+
+	var JD0 = GetJulianDate(time.Date(y, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	var JD1 = GetJulianDate(time.Date(y+1, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	return float64(y) + (GetJulianDate(datetime)-JD0)/(JD1-JD0)
+}
+
+/*
+	GetDeltaTEspenakMeeus()
+
+	@param y - the decimal year, e.g., 1990.5
+	@returns the difference ΔT = TT - UT1 (in seconds), as given by the polynomial expressions of Espenak & Meeus, valid from -1999 to +3000
+	@see Espenak, F. & Meeus, J. 2006. Five Millennium Canon of Solar Eclipses: -1999 to +3000. NASA Tech. Pub. 2006-214141.
+*/
+func GetDeltaTEspenakMeeus(y float64) float64 {
+	switch {
+	case y < -500:
+		var u = (y - 1820) / 100
+
+		return -20 + 32*math.Pow(u, 2)
+	case y < 500:
+		var u = y / 100
+
+		return 10583.6 - 1014.41*u + 33.78311*math.Pow(u, 2) - 5.952053*math.Pow(u, 3) - 0.1798452*math.Pow(u, 4) + 0.022174192*math.Pow(u, 5) + 0.0090316521*math.Pow(u, 6)
+	case y < 1600:
+		var u = (y - 1000) / 100
+
+		return 1574.2 - 556.01*u + 71.23472*math.Pow(u, 2) + 0.319781*math.Pow(u, 3) - 0.8503463*math.Pow(u, 4) - 0.005050998*math.Pow(u, 5) + 0.0083572073*math.Pow(u, 6)
+	case y < 1700:
+		var t = y - 1600
+
+		return 120 - 0.9808*t - 0.01532*math.Pow(t, 2) + math.Pow(t, 3)/7129
+	case y < 1800:
+		var t = y - 1700
+
+		return 8.83 + 0.1603*t - 0.0059285*math.Pow(t, 2) + 0.00013336*math.Pow(t, 3) - math.Pow(t, 4)/1174000
+	case y < 1860:
+		var t = y - 1800
+
+		return 13.72 - 0.332447*t + 0.0068612*math.Pow(t, 2) + 0.0041116*math.Pow(t, 3) - 0.00037436*math.Pow(t, 4) + 0.0000121272*math.Pow(t, 5) - 0.0000001699*math.Pow(t, 6) + 0.000000000875*math.Pow(t, 7)
+	case y < 1900:
+		var t = y - 1860
+
+		return 7.62 + 0.5737*t - 0.251754*math.Pow(t, 2) + 0.01680668*math.Pow(t, 3) - 0.0004473624*math.Pow(t, 4) + math.Pow(t, 5)/233174
+	case y < 1920:
+		var t = y - 1900
+
+		return -2.79 + 1.494119*t - 0.0598939*math.Pow(t, 2) + 0.0061966*math.Pow(t, 3) - 0.000197*math.Pow(t, 4)
+	case y < 1941:
+		var t = y - 1920
+
+		return 21.20 + 0.84493*t - 0.076100*math.Pow(t, 2) + 0.0020936*math.Pow(t, 3)
+	case y < 1961:
+		var t = y - 1950
+
+		return 29.07 + 0.407*t - math.Pow(t, 2)/233 + math.Pow(t, 3)/2547
+	case y < 1986:
+		var t = y - 1975
+
+		return 45.45 + 1.067*t - math.Pow(t, 2)/260 - math.Pow(t, 3)/718
+	case y < 2005:
+		var t = y - 2000
+
+		return 63.86 + 0.3345*t - 0.060374*math.Pow(t, 2) + 0.0017275*math.Pow(t, 3) + 0.000651814*math.Pow(t, 4) + 0.00002373599*math.Pow(t, 5)
+	case y < 2050:
+		var t = y - 2000
+
+		return 62.92 + 0.32217*t + 0.005589*math.Pow(t, 2)
+	case y < 2150:
+		var u = (y - 1820) / 100
+
+		return -20 + 32*math.Pow(u, 2) - 0.5628*(2150-y)
+	default:
+		var u = (y - 1820) / 100
+
+		return -20 + 32*math.Pow(u, 2)
+	}
+}
+
+/*
+	GetDeltaTStephensonMorrisonHohenkerk()
+
+	@param y - the decimal year, e.g., -3000.5
+	@returns the difference ΔT = TT - UT1 (in seconds), as given by the long-term parabola fitted to the historical
+	eclipse record, for use over many millennia
+	@see Stephenson, F.R., Morrison, L.V. & Hohenkerk, C.Y. 2016. Measurement of the Earth's rotation: 720 BC to AD 2015. Proc. R. Soc. A 472: 20160404.
+*/
+func GetDeltaTStephensonMorrisonHohenkerk(y float64) float64 {
+	var u = (y - 1825) / 100
+
+	return -320 + 32.5*math.Pow(u, 2)
+}
+
+/*
+	getDeltaTExtrapolation()
+
+	@param y - the decimal year, e.g., 1990.5
+	@returns the difference ΔT = TT - UT1 (in seconds), from the polynomial expressions of Espenak & Meeus from -1999 to
+	+3000, and beyond them the long-term parabola of Stephenson, Morrison & Hohenkerk (offset to be continuous with the
+	polynomial expressions at either limit)
+*/
+func getDeltaTExtrapolation(y float64) float64 {
+	switch {
+	case y < -1999:
+		return GetDeltaTStephensonMorrisonHohenkerk(y) - GetDeltaTStephensonMorrisonHohenkerk(-1999) + GetDeltaTEspenakMeeus(-1999)
+	case y > 3000:
+		return GetDeltaTStephensonMorrisonHohenkerk(y) - GetDeltaTStephensonMorrisonHohenkerk(3000) + GetDeltaTEspenakMeeus(3000)
+	default:
+		return GetDeltaTEspenakMeeus(y)
+	}
+}
+
+/*
+	getDeltaTTableLimit()
+
+	@returns the Julian date (in UTC) up to which ΔT is known from the leap-second table and any Earth orientation
+	parameters set by SetEarthOrientationTable(), and the difference ΔT = TT - UT1 (in seconds) at that date
+*/
+func getDeltaTTableLimit() (float64, float64) {
+	var JD = GetJulianDate(LEAP_SECONDS_EXPIRY)
+
+	var ΔT = TT_MINUS_TAI + GetTAIMinusUTC(LEAP_SECONDS_EXPIRY) - GetUT1MinusUTC(LEAP_SECONDS_EXPIRY)
+
+	earthOrientationMutex.RLock()
+
+	var t = earthOrientationTable
+
+	earthOrientationMutex.RUnlock()
+
+	if t == nil || len(t.entries) == 0 {
+		return JD, ΔT
+	}
+
+	var last = t.entries[len(t.entries)-1]
+
+	if last.MJD+2400000.5 > JD {
+		JD = last.MJD + 2400000.5
+
+		ΔT = TT_MINUS_TAI + GetTAIMinusUTC(GetUniversalTime(JD)) - last.UT1MinusUTC
+	}
+
+	return JD, ΔT
+}
+
+/*
+	GetDeltaT()
+
+	N.B. within the era of UTC, up to the expiry of the leap-second table (or the end of the Earth orientation parameters,
+	if later), ΔT follows exactly from the leap-second table (as TT - UTC) and DUT1. Otherwise the polynomial expressions
+	of Espenak & Meeus are used from -1999 to +3000, and beyond them the long-term parabola of Stephenson, Morrison &
+	Hohenkerk (offset to be continuous with the polynomial expressions at either limit). After the expiry of the table,
+	the extrapolation is offset to be continuous with the last known value.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the difference ΔT = TT - UT1 (in seconds)
+*/
+func GetDeltaT(datetime time.Time) float64 {
+	if !IsCoordinatedUniversalTime(datetime) {
+		return getDeltaTExtrapolation(GetDecimalYear(datetime))
+	}
+
+	var JD, ΔT = getDeltaTTableLimit()
+
+	if GetJulianDate(datetime) > JD {
+		return getDeltaTExtrapolation(GetDecimalYear(datetime)) - getDeltaTExtrapolation(GetDecimalYear(GetUniversalTime(JD))) + ΔT
+	}
+
+	return TT_MINUS_TAI + GetTAIMinusUTC(datetime) - GetUT1MinusUTC(datetime)
+}
+
+/*
+	getTTMinusUTC()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the difference TT - UTC (in seconds)
+*/
+func getTTMinusUTC(datetime time.Time) float64 {
+	return GetDeltaT(datetime) + GetUT1MinusUTC(datetime)
+}
+
+/*
+	addSeconds()
+
+	@param datetime - the datetime
+	@param seconds - the number of (fractional) seconds to add
+	@returns the datetime offset by the number of seconds, to the nearest nanosecond
+*/
+func addSeconds(datetime time.Time, seconds float64) time.Time {
+	return datetime.Add(time.Duration(math.Round(seconds * 1e9)))
+}
+
+/*
+	ConvertUTCToTAI()
+
+	N.B. the returned time.Time carries the reading of a TAI clock, and its location is nominal.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the International Atomic Time (TAI)
+*/
+func ConvertUTCToTAI(datetime time.Time) time.Time {
+	return addSeconds(datetime, getTTMinusUTC(datetime)-TT_MINUS_TAI)
+}
+
+/*
+	ConvertUTCToTT()
+
+	N.B. the returned time.Time carries the reading of a TT clock, and its location is nominal.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the Terrestrial (dynamical) Time (TT)
+*/
+func ConvertUTCToTT(datetime time.Time) time.Time {
+	return addSeconds(datetime, getTTMinusUTC(datetime))
+}
+
+/*
+	ConvertUTCToTDB()
+
+	N.B. the returned time.Time carries the reading of a TDB clock, and its location is nominal.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the Barycentric Dynamical Time (TDB)
+*/
+func ConvertUTCToTDB(datetime time.Time) time.Time {
+	var tt = ConvertUTCToTT(datetime)
+
+	return addSeconds(tt, GetTDBMinusTT(GetJulianDate(tt)))
+}
+
+/*
+	ConvertUTCToUT1()
+
+	N.B. the returned time.Time carries the reading of UT1, and its location is nominal.
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the Universal Time (UT1)
+*/
+func ConvertUTCToUT1(datetime time.Time) time.Time {
+	return addSeconds(datetime, GetUT1MinusUTC(datetime))
+}
+
+/*
+	ConvertTTToUTC()
+
+	@param datetime - the reading of a Terrestrial Time (TT) clock
+	@returns the corresponding Coordinated Universal Time (UTC)
+*/
+func ConvertTTToUTC(datetime time.Time) time.Time {
+	var utc = addSeconds(datetime, -getTTMinusUTC(datetime))
+
+	// iterate, as the offset is a function of UTC rather than of TT:
+	for i := 0; i < 3; i++ {
+		utc = addSeconds(datetime, -getTTMinusUTC(utc))
+	}
+
+	return utc
+}
+
+/*
+	ConvertTAIToUTC()
+
+	@param datetime - the reading of an International Atomic Time (TAI) clock
+	@returns the corresponding Coordinated Universal Time (UTC)
+*/
+func ConvertTAIToUTC(datetime time.Time) time.Time {
+	return ConvertTTToUTC(addSeconds(datetime, TT_MINUS_TAI))
+}
+
+/*
+	ConvertUT1ToUTC()
+
+	@param datetime - the reading of Universal Time (UT1)
+	@returns the corresponding Coordinated Universal Time (UTC)
+*/
+func ConvertUT1ToUTC(datetime time.Time) time.Time {
+	return addSeconds(datetime, -GetUT1MinusUTC(datetime))
+}
+
+/*
+	GetTDBMinusTT()
+
+	@param JD - the Julian date (in TT)
+	@returns the periodic difference TDB - TT (in seconds), which never exceeds 2 milliseconds
+	@see p.5 of the Explanatory Supplement to the Astronomical Almanac. 1992. Mill Valley, Ca: University Science Books.
+*/
+func GetTDBMinusTT(JD float64) float64 {
+	// the mean anomaly of the Earth in its orbit:
+	var g = 357.53 + 0.98560028*(JD-J2000)
+
+	return 0.001657*sinx(g) + 0.000014*sinx(2*g)
+}
+
+/*
+	GetJulianEphemerisDate()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the Julian Ephemeris Day (JDE), i.e., the Julian date in Terrestrial Time (TT)
+	@see ch.10 p.71 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
+*/
+func GetJulianEphemerisDate(datetime time.Time) float64 {
+	return GetJulianDate(datetime) + getTTMinusUTC(datetime)/86400
+}
+
+/*
+	GetJulianDateUT1()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the Julian date in Universal Time (UT1)
+*/
+func GetJulianDateUT1(datetime time.Time) float64 {
+	return GetJulianDate(datetime) + GetUT1MinusUTC(datetime)/86400
+}
+
+/*
+	GetFractionalJulianEphemerisDaysSinceStandardEpoch()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the number of fractional days of Terrestrial Time (TT) elapsed since the standard epoch J2000
+*/
+func GetFractionalJulianEphemerisDaysSinceStandardEpoch(datetime time.Time) float64 {
+	return GetJulianEphemerisDate(datetime) - J2000
+}
+
+/*
+	GetCurrentJulianEphemerisCenturyRelativeToJ2000()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@returns the number of Julian centuries of Terrestrial Time (TT) elapsed since the standard epoch J2000
+*/
+func GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime time.Time) float64 {
+	return (GetJulianEphemerisDate(datetime) - J2000) / 36525
+}
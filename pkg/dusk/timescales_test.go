@@ -0,0 +1,297 @@
+package dusk
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGetTAIMinusUTC(t *testing.T) {
+	var got float64 = GetTAIMinusUTC(datetime)
+
+	var want float64 = 37
+
+	if got != want {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetTAIMinusUTCBeforeLeapSecond(t *testing.T) {
+	// the leap second of 30 June 1992 had not yet been inserted:
+	var got float64 = GetTAIMinusUTC(d)
+
+	var want float64 = 26
+
+	if got != want {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetTAIMinusUTCBefore1972(t *testing.T) {
+	var datetime time.Time = time.Date(1965, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var got float64 = GetTAIMinusUTC(datetime)
+
+	var want float64 = 3.5401300
+
+	if math.Abs(got-want) > 0.0000001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetTAIMinusUTCBeforeUTC(t *testing.T) {
+	var datetime time.Time = time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var got float64 = GetTAIMinusUTC(datetime)
+
+	var want float64 = 0
+
+	if got != want {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetDecimalYear(t *testing.T) {
+	var got float64 = GetDecimalYear(time.Date(2021, 7, 2, 12, 0, 0, 0, time.UTC))
+
+	var want float64 = 2021.5
+
+	if math.Abs(got-want) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetDeltaTEspenakMeeus(t *testing.T) {
+	var tests = []struct {
+		y    float64
+		want float64
+	}{
+		{1600, 120},
+		{1900, -2.79},
+		{1950, 29.07},
+		{1975, 45.45},
+		{2000, 63.86},
+	}
+
+	for _, tt := range tests {
+		var got = GetDeltaTEspenakMeeus(tt.y)
+
+		if math.Abs(got-tt.want) > 0.00001 {
+			t.Errorf("got %f, wanted %f", got, tt.want)
+		}
+	}
+}
+
+func TestGetDeltaT(t *testing.T) {
+	var got float64 = GetDeltaT(datetime)
+
+	var want float64 = 69.184
+
+	if math.Abs(got-want) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetDeltaTMeeus(t *testing.T) {
+	// ΔT for 1990 January 27 is approximately 57 seconds (see ex.10.a p.74 of Meeus):
+	var datetime time.Time = time.Date(1990, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	var got float64 = GetDeltaT(datetime)
+
+	var want float64 = 57.184
+
+	if math.Abs(got-want) > 0.00001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetDeltaTIsContinuousAtTheIntroductionOfUTC(t *testing.T) {
+	var before = GetDeltaT(time.Date(1960, 12, 31, 23, 59, 59, 0, time.UTC))
+
+	var after = GetDeltaT(time.Date(1961, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	if math.Abs(after-before) > 0.5 {
+		t.Errorf("got %f, wanted %f", after, before)
+	}
+}
+
+func TestGetDeltaTIsContinuousAtTheExpiryOfTheLeapSecondTable(t *testing.T) {
+	var before = GetDeltaT(LEAP_SECONDS_EXPIRY.Add(-time.Second))
+
+	var after = GetDeltaT(LEAP_SECONDS_EXPIRY.Add(time.Second))
+
+	if math.Abs(after-before) > 0.001 {
+		t.Errorf("got %f, wanted %f", after, before)
+	}
+}
+
+func TestGetDeltaTIsExtrapolatedBeyondTheLeapSecondTable(t *testing.T) {
+	// ΔT is not held at 69.184 seconds, but grows with the extrapolation of Espenak & Meeus:
+	var got = GetDeltaT(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	var want = GetDeltaTEspenakMeeus(2100) - GetDeltaTEspenakMeeus(GetDecimalYear(LEAP_SECONDS_EXPIRY)) + 69.184
+
+	if math.Abs(got-want) > 0.01 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+
+	if got < 150 {
+		t.Errorf("got %f, wanted > 150", got)
+	}
+}
+
+func TestConvertUTCToTT(t *testing.T) {
+	var got time.Time = ConvertUTCToTT(datetime)
+
+	var want time.Time = time.Date(2021, 5, 14, 0, 1, 9, 184000000, time.UTC)
+
+	if !got.Equal(want) {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestConvertUTCToTAI(t *testing.T) {
+	var got time.Time = ConvertUTCToTAI(datetime)
+
+	var want time.Time = time.Date(2021, 5, 14, 0, 0, 37, 0, time.UTC)
+
+	if !got.Equal(want) {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestConvertUTCToUT1(t *testing.T) {
+	var got time.Time = ConvertUTCToUT1(datetime)
+
+	var want time.Time = datetime.Add(time.Duration(GetUT1MinusUTC(datetime) * 1e9))
+
+	if math.Abs(got.Sub(want).Seconds()) > 0.000001 {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestConvertUTCToTDB(t *testing.T) {
+	var got = ConvertUTCToTDB(datetime).Sub(ConvertUTCToTT(datetime)).Seconds()
+
+	// TDB - TT never exceeds 1.7 milliseconds:
+	if math.Abs(got) > 0.0017 {
+		t.Errorf("got %f, wanted less than %f", got, 0.0017)
+	}
+}
+
+func TestConvertTTToUTC(t *testing.T) {
+	var datetimes = []time.Time{
+		datetime,
+		d,
+		time.Date(1966, 6, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(1900, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, want := range datetimes {
+		var got = ConvertTTToUTC(ConvertUTCToTT(want))
+
+		if math.Abs(got.Sub(want).Seconds()) > 0.000001 {
+			t.Errorf("got %q, wanted %q", got, want)
+		}
+	}
+}
+
+func TestConvertTAIToUTC(t *testing.T) {
+	var got = ConvertTAIToUTC(ConvertUTCToTAI(datetime))
+
+	if !got.Equal(datetime) {
+		t.Errorf("got %q, wanted %q", got, datetime)
+	}
+}
+
+func TestGetJulianEphemerisDate(t *testing.T) {
+	var got float64 = GetJulianEphemerisDate(datetime)
+
+	var want float64 = 2459348.5 + 69.184/86400
+
+	if math.Abs(got-want) > 0.00000001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetCurrentJulianEphemerisCenturyRelativeToJ2000(t *testing.T) {
+	var got float64 = GetCurrentJulianEphemerisCenturyRelativeToJ2000(datetime)
+
+	var want float64 = (2459348.5 + 69.184/86400 - 2451545.0) / 36525
+
+	if math.Abs(got-want) > 0.00000001 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetDeltaTStephensonMorrisonHohenkerk(t *testing.T) {
+	var got float64 = GetDeltaTStephensonMorrisonHohenkerk(1825)
+
+	var want float64 = -320
+
+	if got != want {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestGetDeltaTIsContinuousAtTheLimitsOfEspenakMeeus(t *testing.T) {
+	var datetimes = [][2]time.Time{
+		{time.Date(-2000, 12, 30, 0, 0, 0, 0, time.UTC), time.Date(-1999, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2999, 12, 30, 0, 0, 0, 0, time.UTC), time.Date(3000, 1, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range datetimes {
+		var before = GetDeltaT(tt[0])
+
+		var after = GetDeltaT(tt[1])
+
+		if math.Abs(after-before) > 1 {
+			t.Errorf("got %f, wanted %f", after, before)
+		}
+	}
+}
+
+func TestGetDeltaTLongTerm(t *testing.T) {
+	// approximately 3.6 days at 8000 BCE:
+	var got float64 = GetDeltaT(time.Date(-7999, 1, 1, 0, 0, 0, 0, time.UTC)) / 86400
+
+	var want float64 = 3.6
+
+	if math.Abs(got-want) > 0.1 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestConvertUTCToTTYear5000(t *testing.T) {
+	var datetime time.Time = time.Date(5000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var tt = ConvertUTCToTT(datetime)
+
+	// the long-term parabola gives ΔT of ~9.1 hours by the year 5000, rather than the 69.184 seconds of the leap-second table:
+	var got = tt.Sub(datetime).Seconds()
+
+	var want float64 = 32704
+
+	if math.Abs(got-want) > 60 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+
+	if back := ConvertTTToUTC(tt); math.Abs(back.Sub(datetime).Seconds()) > 0.001 {
+		t.Errorf("got %q, wanted %q", back, datetime)
+	}
+}
+
+func TestConvertTTToUTCLongTerm(t *testing.T) {
+	var datetimes = []time.Time{
+		time.Date(-7999, 6, 21, 0, 0, 0, 0, time.UTC),
+		time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, want := range datetimes {
+		var got = ConvertTTToUTC(ConvertUTCToTT(want))
+
+		if math.Abs(got.Sub(want).Seconds()) > 0.001 {
+			t.Errorf("got %q, wanted %q", got, want)
+		}
+	}
+}
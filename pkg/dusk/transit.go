@@ -30,6 +30,27 @@ func GetDoesObjectRiseOrSet(eq EquatorialCoordinate, latitude float64) bool {
 	return math.Abs(Ar) < 1 && math.Abs(H1) < 1
 }
 
+/*
+GetObjectRiseObjectSetAzimuths()
+
+@param eq - the EquatorialCoordinate{} of the object, referred to the equinox of date
+@param latitude - the latitude of the observer
+@returns the azimuths (in degrees, measured eastwards from north) at which the object rises and sets on the geometric horizon,
+and whether the object rises or sets at all for the given Observer's latitude
+@see p.117 of Lawrence, J.L. 2015. Celestial Calculations - A Gentle Introduction To Computational Astronomy. Cambridge, Ma: The MIT Press
+*/
+func GetObjectRiseObjectSetAzimuths(eq EquatorialCoordinate, latitude float64) (float64, float64, bool) {
+	if !GetDoesObjectRiseOrSet(eq, latitude) {
+		return 0, 0, false
+	}
+
+	Ar := sinx(eq.Declination) / cosx(latitude)
+
+	R := acosx(Ar)
+
+	return R, 360 - R, true
+}
+
 /*
 GetObjectRiseObjectSetTimesInUTCForDay()
 
@@ -327,3 +348,112 @@ func GetObjectTransit(datetime time.Time, eq EquatorialCoordinate, latitude floa
 		Duration: transit.Duration,
 	}, nil
 }
+
+/*
+GetBodyHorizontalCoordinatesForDay()
+
+@param datetime - the datetime of the observer (in UTC)
+@param body - the body of type Body, e.g., BodyMoon
+@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+@param ephemeris - the ephemeris backend, e.g., MeeusEphemeris{}
+@returns the horizontal coordinates of the body for every minute of a given day.
+*/
+func GetBodyHorizontalCoordinatesForDay(datetime time.Time, body Body, longitude float64, latitude float64, ephemeris Ephemeris) ([]TransitHorizontalCoordinate, error) {
+	// create an empty list of horizontalCoordinate structs:
+	horizontalCoordinates := make([]TransitHorizontalCoordinate, 1442)
+
+	// get the corresponding timezone for the longitude and latitude provided:
+	timezone := tzm.LatLngToTimezoneString(latitude, longitude)
+
+	location, err := time.LoadLocation(timezone)
+
+	if err != nil {
+		return horizontalCoordinates, err
+	}
+
+	var d = time.Date(datetime.Year(), datetime.Month(), datetime.Day(), 0, 0, 0, 0, location).In(time.UTC)
+
+	// Subtract one minute to ensure we are not over looking the rise time to be
+	d = d.Add(time.Minute * -1)
+
+	for i := range horizontalCoordinates {
+		// Get the current equatorial position of the body:
+		eq, err := GetEquatorialPosition(d, body, ephemeris)
+
+		if err != nil {
+			return horizontalCoordinates, err
+		}
+
+		var hz HorizontalCoordinate = ConvertEquatorialCoordinateToHorizontal(d, longitude, latitude, eq)
+
+		if i > 0 {
+			horizontalCoordinates[i] = TransitHorizontalCoordinate{
+				Datetime: d.In(location),
+				Altitude: hz.Altitude,
+				Azimuth:  hz.Azimuth,
+				IsRise:   hz.Altitude > 0 && horizontalCoordinates[i-1].Altitude <= 0,
+				IsSet:    hz.Altitude < 0 && horizontalCoordinates[i-1].Altitude >= 0,
+			}
+		} else {
+			horizontalCoordinates[i] = TransitHorizontalCoordinate{
+				Datetime: d.In(location),
+				Altitude: hz.Altitude,
+				Azimuth:  hz.Azimuth,
+				IsRise:   false,
+				IsSet:    false,
+			}
+		}
+
+		d = d.Add(time.Minute)
+	}
+
+	return horizontalCoordinates[1:1441], nil
+}
+
+/*
+GetBodyTransit()
+
+@param datetime - the datetime of the observer (in UTC)
+@param body - the body of type Body, e.g., BodyJupiter
+@param latitude - the latitude of the observer
+@param longitude - the longitude of the observer
+@param ephemeris - the ephemeris backend, e.g., MeeusEphemeris{}
+@returns a Transit struct which contains the first rise, the maximum and the first set of the body on the observer's local
+day (in local time), where the rise or set is nil if the body does not rise or set on that day
+*/
+func GetBodyTransit(datetime time.Time, body Body, latitude float64, longitude float64, ephemeris Ephemeris) (*Transit, error) {
+	horizontalCoordinates, err := GetBodyHorizontalCoordinatesForDay(datetime, body, longitude, latitude, ephemeris)
+
+	if err != nil {
+		return nil, err
+	}
+
+	transit := Transit{}
+
+	maximum := &horizontalCoordinates[0]
+
+	for i := range horizontalCoordinates {
+		v := &horizontalCoordinates[i]
+
+		if v.IsRise && transit.Rise == nil {
+			transit.Rise = &v.Datetime
+		}
+
+		if v.IsSet && transit.Set == nil {
+			transit.Set = &v.Datetime
+		}
+
+		if v.Altitude > maximum.Altitude {
+			maximum = v
+		}
+	}
+
+	transit.Maximum = &maximum.Datetime
+
+	if transit.Rise != nil && transit.Set != nil {
+		transit.Duration = transit.Set.Sub(*transit.Rise)
+	}
+
+	return &transit, nil
+}
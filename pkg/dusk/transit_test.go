@@ -1,6 +1,7 @@
 package dusk
 
 import (
+	"math"
 	"testing"
 	"time"
 )
@@ -45,6 +46,30 @@ func TestGetDoesObjectRiseOrSetArcturusSouthernHemisphere(t *testing.T) {
 	}
 }
 
+func TestGetObjectRiseObjectSetAzimuthsBetelgeuse(t *testing.T) {
+	var rise, set, ok = GetObjectRiseObjectSetAzimuths(EquatorialCoordinate{RightAscension: 88.7929583, Declination: 7.4070639}, 38.778132)
+
+	if !ok {
+		t.Errorf("got %t, wanted %t", ok, true)
+	}
+
+	if math.Abs(rise-80.480889) > 0.001 {
+		t.Errorf("got %f, wanted %f", rise, 80.480889)
+	}
+
+	if math.Abs(set-(360-80.480889)) > 0.001 {
+		t.Errorf("got %f, wanted %f", set, 360-80.480889)
+	}
+}
+
+func TestGetObjectRiseObjectSetAzimuthsCircumpolar(t *testing.T) {
+	var _, _, ok = GetObjectRiseObjectSetAzimuths(EquatorialCoordinate{RightAscension: 37.954561, Declination: 89.264109}, 38.778132)
+
+	if ok {
+		t.Errorf("got %t, wanted %t", ok, false)
+	}
+}
+
 func TestGetObjectHorizontalCoordinatesForDay(t *testing.T) {
 	var datetime time.Time = time.Date(2022, 5, 14, 0, 0, 0, 0, time.UTC)
 
@@ -264,3 +289,39 @@ func TestGetObjectTransitNoRiseNoSetNoMaximum(t *testing.T) {
 		t.Errorf("got %v, but expected the object to never reach a maxima above the horizon for the given paramaters", got)
 	}
 }
+
+func TestGetBodyTransitMoon(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 5, 6, 0, 0, 0, 0, time.UTC)
+
+	moon, err := GetMoonriseMoonsetTimes(datetime, longitude, latitude)
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	transit, err := GetBodyTransit(datetime, BodyMoon, latitude, longitude, LawrenceEphemeris{})
+
+	if err != nil {
+		t.Errorf("got %q", err)
+	}
+
+	if transit.Rise == nil || !transit.Rise.Equal(moon.Rise) {
+		t.Errorf("got %v, wanted %q", transit.Rise, moon.Rise)
+	}
+
+	if transit.Set == nil || !transit.Set.Equal(moon.Set) {
+		t.Errorf("got %v, wanted %q", transit.Set, moon.Set)
+	}
+
+	if transit.Maximum == nil || transit.Maximum.Before(*transit.Rise) || transit.Maximum.After(*transit.Set) {
+		t.Errorf("got %v, wanted a maximum between the rise and set", transit.Maximum)
+	}
+}
+
+func TestGetBodyTransitUnknownBody(t *testing.T) {
+	_, err := GetBodyTransit(datetime, Body(99), latitude, longitude, MeeusEphemeris{})
+
+	if err == nil {
+		t.Errorf("expected an error for an unknown body")
+	}
+}
@@ -28,6 +28,8 @@ type Twilight struct {
 /*
 	GetLocalTwilight()
 
+	N.B. the twilight is from the accurate apparent Sun of VSOP87Ephemeris{}, as for GetLocalTwilightForEphemeris().
+
 	@param datetime - the datetime of the observer (in UTC)
 	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
 	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
@@ -36,12 +38,35 @@ type Twilight struct {
 	@returns the start and end times of Civil Twilight, as designated by when the Sun is -6 degrees below the horizon.
 */
 func GetLocalTwilight(datetime time.Time, longitude float64, latitude float64, elevation float64, degreesBelowHorizon float64) (*Twilight, *time.Location, error) {
+	return GetLocalTwilightForEphemeris(datetime, longitude, latitude, elevation, degreesBelowHorizon, VSOP87Ephemeris{})
+}
+
+/*
+	GetLocalTwilightForEphemeris()
+
+	@param datetime - the datetime of the observer (in UTC)
+	@param longitude - is the longitude (west is negative, east is positive) in degrees of some observer on Earth
+	@param latitude - is the latitude (south is negative, north is positive) in degrees of some observer on Earth
+	@param elevation - is the elevation (above sea level) in meters of some observer on Earth
+	@param degreesBelowHorizon - is the degrees below horizon for the designated "twilight period", with 0° being "night" e.g., as soon as the sun is below the horizon.
+	@param ephemeris - the ephemeris backend, e.g., MeeusEphemeris{}
+	@returns the start and end times of the twilight period, as given by the position of the Sun of the ephemeris.
+*/
+func GetLocalTwilightForEphemeris(datetime time.Time, longitude float64, latitude float64, elevation float64, degreesBelowHorizon float64, ephemeris Ephemeris) (*Twilight, *time.Location, error) {
 	// get the corresponding timezone for the longitude and latitude provided:
 	timezone := tzm.LatLngToTimezoneString(latitude, longitude)
 
-	var s Sun = GetSunriseSunsetTimesInUTC(datetime, degreesBelowHorizon, longitude, latitude, elevation)
+	s, err := GetSunriseSunsetTimesInUTCForEphemeris(datetime, degreesBelowHorizon, longitude, latitude, elevation, ephemeris)
+
+	if err != nil {
+		return nil, nil, err
+	}
 
-	var r Sun = GetSunriseSunsetTimesInUTC(datetime.Add(time.Hour*24), degreesBelowHorizon, longitude, latitude, elevation)
+	r, err := GetSunriseSunsetTimesInUTCForEphemeris(datetime.Add(time.Hour*24), degreesBelowHorizon, longitude, latitude, elevation, ephemeris)
+
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// the corresponding local timezone for the observer, e..g, the location name corresponding to a file in the IANA Time Zone database, such as "Pacific/Honolulu":
 	location, err := time.LoadLocation(timezone)
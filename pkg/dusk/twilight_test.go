@@ -1,6 +1,7 @@
 package dusk
 
 import (
+	"math"
 	"testing"
 	"time"
 )
@@ -26,7 +27,7 @@ func TestGetLocalCivilTwilightFrom(t *testing.T) {
 
 	var got time.Time = twilight.From
 
-	var want = time.Date(1992, 4, 12, 19, 03, 52, 618345344, timezone)
+	var want = time.Date(1992, 4, 12, 19, 5, 16, 56678256, timezone)
 
 	if got.Before(want) {
 		t.Errorf("got %q, wanted %q", got, want)
@@ -62,7 +63,7 @@ func TestGetLocalCivilTwilightUntil(t *testing.T) {
 
 	var got time.Time = twilight.Until
 
-	var want = time.Date(1992, 4, 13, 5, 39, 45, 686235136, timezone)
+	var want = time.Date(1992, 4, 13, 5, 39, 11, 102765714, timezone)
 
 	if got.Before(want) {
 		t.Errorf("got %q, wanted %q", got, want)
@@ -98,7 +99,7 @@ func TestGetLocalCivilTwilightDuration(t *testing.T) {
 
 	var got time.Duration = twilight.Duration
 
-	var want time.Duration = 38153067889792
+	var want time.Duration = 38035046087458
 
 	if got.Nanoseconds() != want.Nanoseconds() {
 		t.Errorf("got %d, wanted %d", got.Nanoseconds(), want.Nanoseconds())
@@ -126,7 +127,7 @@ func TestGetLocalNauticalTwilightFrom(t *testing.T) {
 
 	var got time.Time = twilight.From
 
-	var want = time.Date(1992, 4, 12, 19, 29, 24, 855337216, timezone)
+	var want = time.Date(1992, 4, 12, 19, 31, 30, 693422078, timezone)
 
 	if got.Before(want) {
 		t.Errorf("got %q, wanted %q", got, want)
@@ -162,7 +163,7 @@ func TestGetLocalNauticalTwilightUntil(t *testing.T) {
 
 	var got time.Time = twilight.Until
 
-	var want = time.Date(1992, 4, 13, 5, 14, 14, 269436032, timezone)
+	var want = time.Date(1992, 4, 13, 5, 12, 56, 654022213, timezone)
 
 	if got.Before(want) {
 		t.Errorf("got %q, wanted %q", got, want)
@@ -198,7 +199,7 @@ func TestGetLocalNauticalTwilightDuration(t *testing.T) {
 
 	var got time.Duration = twilight.Duration
 
-	var want time.Duration = 35089414098816
+	var want time.Duration = 34885960600135
 
 	if got.Nanoseconds() != want.Nanoseconds() {
 		t.Errorf("got %d, wanted %d", got.Nanoseconds(), want.Nanoseconds())
@@ -226,7 +227,7 @@ func TestGetLocalAstronomicalTwilightFrom(t *testing.T) {
 
 	var got time.Time = twilight.From
 
-	var want = time.Date(1992, 4, 12, 19, 55, 12, 555572352, timezone)
+	var want = time.Date(1992, 4, 12, 19, 58, 4, 531985094, timezone)
 
 	if got.Before(want) {
 		t.Errorf("got %q, wanted %q", got, want)
@@ -262,7 +263,7 @@ func TestGetLocalAstronomicalTwilightUntil(t *testing.T) {
 
 	var got time.Time = twilight.Until
 
-	var want = time.Date(1992, 4, 13, 4, 48, 27, 39767424, timezone)
+	var want = time.Date(1992, 4, 13, 4, 46, 22, 961153466, timezone)
 
 	if got.Before(want) {
 		t.Errorf("got %q, wanted %q", got, want)
@@ -298,9 +299,38 @@ func TestGetLocalAstronomicalTwilightDuration(t *testing.T) {
 
 	var got time.Duration = twilight.Duration
 
-	var want time.Duration = 31994484195072
+	var want time.Duration = 31698429168372
 
 	if got.Nanoseconds() != want.Nanoseconds() {
 		t.Errorf("got %d, wanted %d", got.Nanoseconds(), want.Nanoseconds())
 	}
 }
+
+func TestGetLocalTwilightForEphemeris(t *testing.T) {
+	var datetime time.Time = time.Date(2021, 5, 14, 0, 0, 0, 0, time.UTC)
+
+	for _, ephemeris := range []Ephemeris{MeeusEphemeris{}, VSOP87Ephemeris{}} {
+		got, location, err := GetLocalTwilightForEphemeris(datetime, longitude, latitude, elevation, -18, ephemeris)
+
+		if err != nil {
+			t.Errorf("got %q", err)
+		}
+
+		if location.String() != "Pacific/Honolulu" {
+			t.Errorf("got %q, wanted %q", location.String(), "Pacific/Honolulu")
+		}
+
+		// the Sun is 18° below the horizon at an hour angle of ~119°, i.e., ~7h56m either side of the solar transit at ~12:18 HST:
+		var from = time.Date(2021, 5, 14, 20, 14, 0, 0, location)
+
+		var until = time.Date(2021, 5, 15, 4, 22, 0, 0, location)
+
+		if math.Abs(got.From.Sub(from).Minutes()) > 2 {
+			t.Errorf("got %q, wanted %q", got.From, from)
+		}
+
+		if math.Abs(got.Until.Sub(until).Minutes()) > 2 {
+			t.Errorf("got %q, wanted %q", got.Until, until)
+		}
+	}
+}
@@ -45,7 +45,7 @@ func GetMeanObliquityOfTheEcliptic(J float64) float64 {
 	@see p.144 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
 */
 func GetNutationInLongitudeOfTheEcliptic(L float64, l float64, Ω float64) float64 {
-	return (-17.20*sinx(Ω) + 1.32*sinx(2*L) - 0.23*sinx(2*l) + 0.21*sinx(2*Ω)) / 3600
+	return (-17.20*sinx(Ω) - 1.32*sinx(2*L) - 0.23*sinx(2*l) + 0.21*sinx(2*Ω)) / 3600
 }
 
 /*
@@ -77,11 +77,12 @@ func GetArgumentOfLocalSiderealTimeForTransit(latitude float64, δ float64) floa
 	GetAtmosphericRefraction()
 
 	@param altitude - is the altitude of the object in degrees
-	@returns the atmospheric refraction in degrees for all angles from 0° - 90°
+	@returns the atmospheric refraction in degrees for all angles from -1° - 90°, where the refraction of ~0.57° at the
+	horizon lifts an object into view while its geometric altitude is still slightly negative
 	@see p.106 of Meeus, Jean. 1991. Astronomical algorithms. Richmond, Va: Willmann-Bell.
 */
 func GetAtmosphericRefraction(altitude float64) *float64 {
-	if altitude < 0 {
+	if altitude < -1 {
 		return nil
 	}
 
@@ -110,15 +111,15 @@ func GetRelativeAirMass(altitude float64) *float64 {
 	GetApparentAltitude()
 
 	@param altitude - is the altitude of the object in degrees
-	@returns the apparent altitude in degrees
+	@returns the apparent altitude in degrees, for all geometric altitudes from -1° - 90°
 */
 func GetApparentAltitude(altitude float64) *float64 {
-	if altitude < 0 {
+	R := GetAtmosphericRefraction(altitude)
+
+	if R == nil {
 		return nil
 	}
 
-	R := GetAtmosphericRefraction(altitude)
-
 	app := altitude + *R
 
 	return &app
@@ -51,9 +51,10 @@ func TestGetNutationInLongitudeOfTheEcliptic(t *testing.T) {
 
 	var got float64 = GetNutationInLongitudeOfTheEcliptic(L, l, Ω)
 
-	var want float64 = -0.000648203
+	// Δψ = -3.788", with the four largest terms accurate to 0.5" (see ex.22.a p.148 of Meeus):
+	var want float64 = -3.788 / 3600
 
-	if math.Abs(got-want) > 0.00001 {
+	if math.Abs(got-want) > 0.00015 {
 		t.Errorf("got %f, wanted %f", got, want)
 	}
 }